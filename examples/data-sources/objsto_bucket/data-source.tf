@@ -0,0 +1,3 @@
+data "objsto_bucket" "example" {
+  bucket = "example"
+}
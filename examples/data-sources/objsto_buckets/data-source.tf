@@ -0,0 +1,21 @@
+data "objsto_buckets" "example" {
+  prefix = "example-"
+}
+
+resource "objsto_bucket_policy" "example" {
+  for_each = toset(data.objsto_buckets.example.names)
+
+  bucket = each.value
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "PublicRead"
+        Effect    = "Allow"
+        Principal = "*"
+        Action    = ["s3:GetObject"]
+        Resource  = ["arn:aws:s3:::${each.value}/*"]
+      }
+    ]
+  })
+}
@@ -0,0 +1,8 @@
+data "objsto_object" "example" {
+  bucket = "example"
+  key    = "config.json"
+}
+
+locals {
+  config = jsondecode(data.objsto_object.example.body)
+}
@@ -0,0 +1,14 @@
+data "objsto_object_versions" "example" {
+  bucket = "example"
+  key    = "config.json"
+}
+
+locals {
+  previous_version = [for v in data.objsto_object_versions.example.versions : v if !v.is_latest][0]
+}
+
+data "objsto_object" "previous" {
+  bucket     = "example"
+  key        = "config.json"
+  version_id = local.previous_version.version_id
+}
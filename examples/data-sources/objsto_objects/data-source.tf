@@ -0,0 +1,9 @@
+data "objsto_objects" "example" {
+  bucket    = "example"
+  prefix    = "releases/"
+  delimiter = "/"
+}
+
+output "release_directories" {
+  value = data.objsto_objects.example.common_prefixes
+}
@@ -0,0 +1,44 @@
+resource "objsto_bucket" "example" {
+  bucket = "example"
+}
+
+data "objsto_policy_document" "public_read" {
+  policy_id = "PublicRead"
+
+  statement {
+    sid     = "ListBucket"
+    actions = ["s3:GetBucketLocation", "s3:ListBucket"]
+    resources = [
+      objsto_bucket.example.arn,
+    ]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+
+  statement {
+    sid     = "GetObject"
+    actions = ["s3:GetObject"]
+    resources = [
+      "${objsto_bucket.example.arn}/*",
+    ]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "IpAddress"
+      variable = "aws:SourceIp"
+      values   = ["192.0.2.0/24"]
+    }
+  }
+}
+
+resource "objsto_bucket_policy" "example" {
+  bucket = objsto_bucket.example.bucket
+  policy = data.objsto_policy_document.public_read.json
+}
@@ -4,3 +4,89 @@ provider "objsto" {
   access_key = "local_access_key"
   secret_key = "local_secret_key"
 }
+
+# Load the endpoint, region, and credentials from a profile in the shared config and credentials files.
+provider "objsto" {
+  alias   = "profile"
+  profile = "objsto"
+
+  shared_config_files      = ["~/.aws/config"]
+  shared_credentials_files = ["~/.aws/credentials"]
+}
+
+# Use temporary credentials of a role assumed with the STS API of the object storage service.
+provider "objsto" {
+  alias    = "assume_role"
+  endpoint = "http://localhost:9000"
+  region   = "localhost"
+
+  assume_role {
+    role_arn     = "arn:aws:iam::123456789012:role/terraform"
+    session_name = "terraform"
+    duration     = "1h"
+  }
+}
+
+# Exchange an OIDC token issued to a CI pipeline for temporary credentials.
+provider "objsto" {
+  alias    = "web_identity"
+  endpoint = "http://localhost:9000"
+  region   = "localhost"
+
+  assume_role_with_web_identity {
+    role_arn                = "arn:aws:iam::123456789012:role/ci"
+    web_identity_token_file = "/var/run/secrets/ci/token"
+    session_name            = "ci"
+  }
+}
+
+# Load the credentials with an external command that prints them in the credential_process JSON format.
+provider "objsto" {
+  alias              = "credential_process"
+  endpoint           = "http://localhost:9000"
+  region             = "localhost"
+  credential_process = "vault-objsto-credentials --role terraform"
+}
+
+# Connect to a service that uses an internal CA and requires mutual TLS authentication.
+provider "objsto" {
+  alias    = "mtls"
+  endpoint = "https://objsto.internal.example.com"
+  region   = "internal"
+
+  ca_bundle          = "/etc/ssl/internal-ca.pem"
+  client_certificate = "/etc/ssl/terraform.crt"
+  client_key         = "/etc/ssl/terraform.key"
+}
+
+# Route the requests through a proxy and keep more connections open for reuse when managing large number of objects.
+provider "objsto" {
+  alias    = "proxy"
+  endpoint = "https://objsto.example.com"
+  region   = "example"
+
+  https_proxy = "http://proxy.internal.example.com:3128"
+  no_proxy    = "localhost,.internal.example.com"
+
+  max_idle_conns_per_host = 64
+  idle_conn_timeout       = "90s"
+  dial_timeout            = "10s"
+
+  # Log only the retry attempts to keep the debug logs of large object uploads compact.
+  log_mode = "retries"
+
+  retry {
+    max_attempts          = 10
+    max_backoff           = "30s"
+    mode                  = "adaptive"
+    retryable_error_codes = ["ServiceUnavailable"]
+  }
+}
+
+# Check the configuration by reading a single bucket, when the credentials are not allowed to list buckets.
+provider "objsto" {
+  alias            = "preflight_bucket"
+  endpoint         = "https://objsto.example.com"
+  region           = "example"
+  preflight_bucket = "terraform-state"
+}
@@ -1,3 +1,7 @@
 resource "objsto_bucket" "example" {
   bucket = "example"
+
+  tags = {
+    environment = "development"
+  }
 }
@@ -8,4 +8,35 @@ resource "objsto_object" "example" {
   content = jsonencode({
     message = "Hello objsto!"
   })
+
+  cache_control = "max-age=300"
+
+  metadata = {
+    build-id = "1234"
+  }
+
+  tags = {
+    retention = "long"
+  }
+}
+
+# Upload a local file. The file is uploaded again when its content changes.
+resource "objsto_object" "archive" {
+  bucket      = objsto_bucket.example.bucket
+  key         = "archive.tar.gz"
+  source      = "${path.module}/archive.tar.gz"
+  source_hash = filemd5("${path.module}/archive.tar.gz")
+
+  # Allow more time for uploading a large file than the default 30 minutes.
+  timeouts {
+    create = "2h"
+    update = "2h"
+  }
+}
+
+# Store binary content that is not valid UTF-8 text.
+resource "objsto_object" "icon" {
+  bucket         = objsto_bucket.example.bucket
+  key            = "favicon.ico"
+  content_base64 = filebase64("${path.module}/favicon.ico")
 }
@@ -0,0 +1,9 @@
+# Tag an object uploaded by an application, e.g., to match a lifecycle rule filter.
+resource "objsto_object_tagging" "example" {
+  bucket = "example"
+  key    = "uploads/report.pdf"
+
+  tags = {
+    retention = "short"
+  }
+}
@@ -7,6 +7,7 @@ import (
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -33,8 +34,9 @@ type BucketCORSConfigurationResource struct {
 
 // BucketCORSConfigurationResourceModel describes the resource data model.
 type BucketCORSConfigurationResourceModel struct {
-	Bucket types.String `tfsdk:"bucket"`
-	Rules  types.List   `tfsdk:"cors_rule"`
+	Bucket   types.String   `tfsdk:"bucket"`
+	Rules    types.List     `tfsdk:"cors_rule"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CORSRule struct {
@@ -105,6 +107,12 @@ func (r *BucketCORSConfigurationResource) Schema(ctx context.Context, req resour
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -182,6 +190,9 @@ func (r *BucketCORSConfigurationResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,6 +205,9 @@ func (r *BucketCORSConfigurationResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	output, err := r.client.GetBucketCors(ctx, &s3.GetBucketCorsInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 	})
@@ -219,6 +233,9 @@ func (r *BucketCORSConfigurationResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultTimeout, "update", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -231,6 +248,9 @@ func (r *BucketCORSConfigurationResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.DeleteBucketCors(ctx, &s3.DeleteBucketCorsInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 	})
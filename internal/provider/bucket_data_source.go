@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketDataSource{}
+
+func NewBucketDataSource() datasource.DataSource {
+	return &BucketDataSource{}
+}
+
+// BucketDataSource defines the data source implementation.
+type BucketDataSource struct {
+	client *s3.Client
+	tags   tagsConfig
+}
+
+// BucketDataSourceModel describes the data source data model.
+type BucketDataSourceModel struct {
+	Name             types.String `tfsdk:"bucket"`
+	ARN              types.String `tfsdk:"arn"`
+	Region           types.String `tfsdk:"region"`
+	CreationDate     types.String `tfsdk:"creation_date"`
+	VersioningStatus types.String `tfsdk:"versioning_status"`
+	Tags             types.Map    `tfsdk:"tags"`
+	URL              types.String `tfsdk:"url"`
+}
+
+func (d *BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A bucket data source that provides details of an existing bucket in an object storage service.",
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ARN of the bucket. Can be used when referencing the bucket in policy documents.",
+			},
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation date of the bucket in RFC3339 format. This is only set if the bucket is owned by the configured credentials and the credentials are allowed to list buckets.",
+			},
+			"region": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The region of the bucket. Defaults to the region of the provider, if the object storage service does not report the region of the bucket.",
+			},
+			"tags": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tags assigned to the bucket.",
+				ElementType:         types.StringType,
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the bucket.",
+			},
+			"versioning_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The versioning status of the bucket. Empty if versioning has never been configured for the bucket.",
+			},
+		},
+	}
+}
+
+func (d *BucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var data *objStoProviderData
+	data, resp.Diagnostics = getProviderData(req.ProviderData)
+	if data != nil {
+		d.client = data.client
+		d.tags = data.tags
+	}
+}
+
+func getBucketCreationDate(ctx context.Context, client *s3.Client, bucket string) (*time.Time, error) {
+	// Not all object storage services support filtering by prefix, so check the bucket names of all returned buckets.
+	paginator := s3.NewListBucketsPaginator(client, &s3.ListBucketsInput{
+		Prefix: &bucket,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range page.Buckets {
+			if b.Name != nil && *b.Name == bucket {
+				return b.CreationDate, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// isAccessDenied checks whether the error is caused by the credentials not being allowed to perform the operation.
+func isAccessDenied(err error) bool {
+	var re *awshttp.ResponseError
+	return errors.As(err, &re) && re.HTTPStatusCode() == http.StatusForbidden
+}
+
+func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BucketDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Name.ValueString()
+	output, err := d.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &bucket})
+	if err != nil {
+		var re *awshttp.ResponseError
+		if errors.As(err, &re) && re.HTTPStatusCode() == 404 {
+			resp.Diagnostics.AddError("Bucket not found", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read bucket", err.Error())
+		return
+	}
+
+	data.ARN = types.StringValue(bucketARN(bucket))
+	data.URL = types.StringValue(buildBucketURL(d.client.Options(), bucket))
+
+	data.Region = types.StringValue(d.client.Options().Region)
+	if output.BucketRegion != nil && *output.BucketRegion != "" {
+		data.Region = types.StringValue(*output.BucketRegion)
+	}
+
+	// Credentials that are allowed to read the bucket are not necessarily allowed to list buckets, leave the creation date unset in that case.
+	creationDate, err := getBucketCreationDate(ctx, d.client, bucket)
+	if err != nil && !isAccessDenied(err) {
+		resp.Diagnostics.AddError("Unable to list buckets", err.Error())
+		return
+	}
+	data.CreationDate = formatTime(creationDate)
+
+	versioning, err := d.client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: &bucket})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read bucket versioning configuration", err.Error())
+		return
+	}
+	data.VersioningStatus = types.StringValue(string(versioning.Status))
+
+	tags, diags := getBucketTags(ctx, d.client, bucket)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags, diags = types.MapValueFrom(ctx, types.StringType, d.tags.withoutIgnored(tags))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBucketDataSource(t *testing.T) {
+	bucket_name := withSuffix("bucket-data-source")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/bucket_data_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_bucket.this", "bucket", bucket_name),
+					resource.TestCheckResourceAttrPair("data.objsto_bucket.this", "arn", "objsto_bucket.this", "arn"),
+					resource.TestCheckResourceAttr("data.objsto_bucket.this", "versioning_status", "Enabled"),
+					resource.TestCheckResourceAttr("data.objsto_bucket.this", "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.objsto_bucket.this", "tags.env", "test"),
+					resource.TestCheckResourceAttrSet("data.objsto_bucket.this", "region"),
+					resource.TestMatchResourceAttr("data.objsto_bucket.this", "url", regexp.MustCompile(regexp.QuoteMeta(bucket_name)+"$")),
+				),
+			},
+		},
+	})
+}
+
+func TestBucketDataSource_readWithoutListBucketsPermission(t *testing.T) {
+	ctx := t.Context()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+		}
+	})
+
+	d := &BucketDataSource{client: client}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	assert.False(t, state.Set(ctx, BucketDataSourceModel{
+		Name: types.StringValue("bucket"),
+		Tags: types.MapNull(types.StringType),
+	}).HasError())
+
+	resp := datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data BucketDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.True(t, data.CreationDate.IsNull())
+	assert.Equal(t, bucketARN("bucket"), data.ARN.ValueString())
+}
@@ -8,6 +8,7 @@ import (
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -41,8 +42,9 @@ type BucketLifecycleConfigurationResource struct {
 
 // BucketLifecycleConfigurationResourceModel describes the resource data model.
 type BucketLifecycleConfigurationResourceModel struct {
-	Bucket types.String `tfsdk:"bucket"`
-	Rules  types.List   `tfsdk:"rule"`
+	Bucket   types.String   `tfsdk:"bucket"`
+	Rules    types.List     `tfsdk:"rule"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type LifecycleConfigurationRule struct {
@@ -281,6 +283,12 @@ func (r *BucketLifecycleConfigurationResource) Schema(ctx context.Context, req r
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -507,6 +515,9 @@ func (r *BucketLifecycleConfigurationResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -519,6 +530,9 @@ func (r *BucketLifecycleConfigurationResource) Read(ctx context.Context, req res
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	output, err := r.client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 	})
@@ -544,6 +558,9 @@ func (r *BucketLifecycleConfigurationResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultTimeout, "update", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -556,6 +573,9 @@ func (r *BucketLifecycleConfigurationResource) Delete(ctx context.Context, req r
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 	})
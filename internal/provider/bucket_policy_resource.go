@@ -9,6 +9,7 @@ import (
 
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -33,8 +34,9 @@ type BucketPolicyResource struct {
 
 // BucketPolicyResourceModel describes the resource data model.
 type BucketPolicyResourceModel struct {
-	Bucket types.String `tfsdk:"bucket"`
-	Policy types.String `tfsdk:"policy"`
+	Bucket   types.String   `tfsdk:"bucket"`
+	Policy   types.String   `tfsdk:"policy"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *BucketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +68,13 @@ func (r *BucketPolicyResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -94,6 +103,9 @@ func (r *BucketPolicyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -106,6 +118,9 @@ func (r *BucketPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	output, err := r.client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: data.Bucket.ValueStringPointer()})
 	if err != nil {
 		var re *awshttp.ResponseError
@@ -149,6 +164,9 @@ func (r *BucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{Bucket: data.Bucket.ValueStringPointer()})
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to delete bucket policy", err.Error())
@@ -4,20 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BucketResource{}
 var _ resource.ResourceWithImportState = &BucketResource{}
+var _ resource.ResourceWithModifyPlan = &BucketResource{}
 
 func NewBucketResource() resource.Resource {
 	return &BucketResource{}
@@ -26,12 +34,21 @@ func NewBucketResource() resource.Resource {
 // BucketResource defines the resource implementation.
 type BucketResource struct {
 	client *s3.Client
+	tags   tagsConfig
 }
 
 // BucketResourceModel describes the resource data model.
 type BucketResourceModel struct {
-	Name types.String `tfsdk:"bucket"`
-	ARN  types.String `tfsdk:"arn"`
+	Name         types.String   `tfsdk:"bucket"`
+	ARN          types.String   `tfsdk:"arn"`
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	Tags         types.Map      `tfsdk:"tags"`
+	TagsAll      types.Map      `tfsdk:"tags_all"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func bucketARN(bucket string) string {
+	return fmt.Sprintf("arn:aws:s3:::%s", bucket)
 }
 
 func (r *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,12 +75,45 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to delete all objects, object versions, delete markers, and incomplete multipart uploads from the bucket before deleting the bucket. Without this, deleting a bucket that is not empty will fail.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: "The tags to assign to the bucket.",
+				ElementType:         types.StringType,
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "All tags assigned to the bucket, including the tags inherited from the provider `default_tags` configuration.",
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client, resp.Diagnostics = getClientFromProviderData(req.ProviderData)
+	var data *objStoProviderData
+	data, resp.Diagnostics = getProviderData(req.ProviderData)
+	if data != nil {
+		r.client = data.client
+		r.tags = data.tags
+	}
+}
+
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.tags.modifyPlanTagsAll(ctx, req, resp)
 }
 
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -76,6 +126,9 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.CreateBucket(ctx, &s3.CreateBucketInput{
 		Bucket: data.Name.ValueStringPointer(),
 	})
@@ -84,10 +137,55 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	data.ARN = types.StringValue(fmt.Sprintf("arn:aws:s3:::%s", data.Name.ValueString()))
+	data.ARN = types.StringValue(bucketARN(data.Name.ValueString()))
+
+	tagsAll, diags := tagsFromMap(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if len(tagsAll) > 0 {
+		resp.Diagnostics.Append(r.putTags(ctx, &data, tagsAll)...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *BucketResource) putTags(ctx context.Context, data *BucketResourceModel, tags map[string]string) (diags diag.Diagnostics) {
+	if len(tags) == 0 {
+		_, err := r.client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+			Bucket: data.Name.ValueStringPointer(),
+		})
+		if err != nil {
+			diags.AddError("Unable to delete bucket tags", err.Error())
+		}
+		return
+	}
+
+	_, err := r.client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket: data.Name.ValueStringPointer(),
+		Tagging: &s3_types.Tagging{
+			TagSet: tagsToS3(tags),
+		},
+	})
+	if err != nil {
+		diags.AddError("Unable to put bucket tags", err.Error())
+	}
+	return
+}
+
+func getBucketTags(ctx context.Context, client *s3.Client, bucket string) (tags map[string]string, diags diag.Diagnostics) {
+	output, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		// Object storage services without tagging support can not have tags.
+		if isNoSuchTagSet(err) || isNotImplemented(err) {
+			return map[string]string{}, nil
+		}
+		diags.AddError("Unable to read bucket tags", err.Error())
+		return
+	}
+	return tagsFromS3(output.TagSet), nil
+}
+
 func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data BucketResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -96,6 +194,9 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: data.Name.ValueStringPointer()})
 	if err != nil {
 		var re *awshttp.ResponseError
@@ -107,12 +208,62 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	data.ARN = types.StringValue(fmt.Sprintf("arn:aws:s3:::%s", data.Name.ValueString()))
+	data.ARN = types.StringValue(bucketARN(data.Name.ValueString()))
+	// force_destroy is not stored in the API, so it is only empty during import.
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
+	tags := map[string]string{}
+	var diags diag.Diagnostics
+	if r.tags.isManaged(data.Tags, data.TagsAll) {
+		tags, diags = getBucketTags(ctx, r.client, data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tagsAll := r.tags.withoutIgnored(tags)
+	data.TagsAll, diags = types.MapValueFrom(ctx, types.StringType, tagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	configuredTags, diags := tagsFromMap(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	data.Tags, diags = tagsToMap(ctx, r.tags.resourceTags(tagsAll, configuredTags), data.Tags)
+	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	passthroughUpdate[BucketResourceModel](ctx, req, resp)
+	var data, state BucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultTimeout, "update", &resp.Diagnostics)
+	defer done()
+
+	tagsAll, diags := tagsFromMap(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	stateTagsAll, diags := tagsFromMap(ctx, state.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if !maps.Equal(tagsAll, stateTagsAll) {
+		// Tags are replaced as a whole, so include ignored tags from the current tags to avoid removing them.
+		currentTags, diags := getBucketTags(ctx, r.client, data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.putTags(ctx, &data, r.tags.withIgnoredFrom(tagsAll, currentTags))...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -123,6 +274,17 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultBucketDeleteTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
+	if data.ForceDestroy.ValueBool() {
+		err := emptyBucket(ctx, r.client, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to empty bucket", err.Error())
+			return
+		}
+	}
+
 	_, err := r.client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: data.Name.ValueStringPointer()})
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to delete bucket", err.Error())
@@ -131,4 +293,98 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+
+	// Read skips the tags of buckets without managed tags, so read the tags of the imported bucket here.
+	tags, diags := getBucketTags(ctx, r.client, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags_all"), r.tags.withoutIgnored(tags))...)
+}
+
+const (
+	// deleteObjectsBatchSize is the maximum number of keys accepted by a single DeleteObjects request.
+	deleteObjectsBatchSize = 1000
+	// emptyBucketConcurrency limits the number of concurrent requests when emptying a bucket.
+	emptyBucketConcurrency = 8
+)
+
+// emptyBucket aborts incomplete multipart uploads and deletes all object versions and delete markers from the bucket.
+func emptyBucket(ctx context.Context, client *s3.Client, bucket string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(emptyBucketConcurrency)
+
+	uploads := s3.NewListMultipartUploadsPaginator(client, &s3.ListMultipartUploadsInput{
+		Bucket: &bucket,
+	})
+	for uploads.HasMorePages() {
+		page, err := uploads.NextPage(gctx)
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to list multipart uploads: %w", err), g.Wait())
+		}
+
+		for _, upload := range page.Uploads {
+			g.Go(func() error {
+				_, err := client.AbortMultipartUpload(gctx, &s3.AbortMultipartUploadInput{
+					Bucket:   &bucket,
+					Key:      upload.Key,
+					UploadId: upload.UploadId,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to abort multipart upload of %s: %w", aws.ToString(upload.Key), err)
+				}
+				return nil
+			})
+		}
+	}
+
+	versions := s3.NewListObjectVersionsPaginator(client, &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+	})
+	for versions.HasMorePages() {
+		page, err := versions.NextPage(gctx)
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to list object versions: %w", err), g.Wait())
+		}
+
+		objects := make([]s3_types.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objects = append(objects, s3_types.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, s3_types.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		for len(objects) > 0 {
+			n := min(len(objects), deleteObjectsBatchSize)
+			batch := objects[:n]
+			objects = objects[n:]
+
+			g.Go(func() error {
+				return deleteObjects(gctx, client, bucket, batch)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+func deleteObjects(ctx context.Context, client *s3.Client, bucket string, objects []s3_types.ObjectIdentifier) error {
+	output, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: &bucket,
+		Delete: &s3_types.Delete{
+			Objects: objects,
+			Quiet:   aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete objects: %w", err)
+	}
+
+	errs := make([]error, 0, len(output.Errors))
+	for _, e := range output.Errors {
+		errs = append(errs, fmt.Errorf("failed to delete %s (version %s): %s: %s", aws.ToString(e.Key), aws.ToString(e.VersionId), aws.ToString(e.Code), aws.ToString(e.Message)))
+	}
+	return errors.Join(errs...)
 }
@@ -1,15 +1,30 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 // From Kubernetes random suffixes.
@@ -93,3 +108,296 @@ func TestAccBucketResource_crud(t *testing.T) {
 		},
 	})
 }
+
+func putObjects(bucket string, keys ...string) {
+	ctx := context.TODO()
+	client := testAccClient(ctx)
+	for _, key := range keys {
+		_, err := client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: &bucket,
+			Key:    &key,
+			Body:   strings.NewReader(key),
+		})
+		if err != nil {
+			panic(fmt.Sprintf("failed to put object: %v", err))
+		}
+	}
+}
+
+func checkBucketIsDeleted(bucket string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.TODO()
+		client := testAccClient(ctx)
+		_, err := client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &bucket})
+
+		var re *awshttp.ResponseError
+		if errors.As(err, &re) && re.HTTPStatusCode() == 404 {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to head bucket: %w", err)
+		}
+		return fmt.Errorf("expected bucket %s to be deleted", bucket)
+	}
+}
+
+func TestAccBucketResource_forceDestroy(t *testing.T) {
+	bucket_name := withSuffix("bucket-force-destroy")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkBucketIsDeleted(bucket_name),
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/bucket_force_destroy.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "force_destroy", "true"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "timeouts.delete", "10m"),
+				),
+			},
+			{
+				// Create objects, object versions, and delete markers outside of Terraform.
+				PreConfig: func() {
+					putObjects(bucket_name, "a.txt", "b.txt", "dir/c.txt", "a.txt")
+					ctx := context.TODO()
+					client := testAccClient(ctx)
+					_, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{
+						Bucket: &bucket_name,
+						Key:    aws.String("b.txt"),
+					})
+					if err != nil {
+						panic(fmt.Sprintf("failed to delete object: %v", err))
+					}
+				},
+				ConfigFile:      config.StaticFile("testdata/bucket_force_destroy.tf"),
+				ConfigVariables: variables,
+			},
+		},
+	})
+}
+
+func stringMapVariable(m map[string]string) config.Variable {
+	variables := make(map[string]config.Variable)
+	for key, value := range m {
+		variables[key] = config.StringVariable(value)
+	}
+	return config.MapVariable(variables)
+}
+
+func TestAccBucketResource_tags(t *testing.T) {
+	bucket_name := withSuffix("bucket-tags")
+	variables := func(tags map[string]string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"bucket_name": config.StringVariable(bucket_name),
+			"tags":        stringMapVariable(tags),
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/bucket_tags.tf"),
+				ConfigVariables: variables(map[string]string{"env": "test", "team": "objsto"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.%", "2"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.env", "test"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.team", "objsto"),
+				),
+			},
+			{
+				ConfigFile:                           config.StaticFile("testdata/bucket_tags.tf"),
+				ConfigVariables:                      variables(map[string]string{"env": "test", "team": "objsto"}),
+				ResourceName:                         "objsto_bucket.this",
+				ImportState:                          true,
+				ImportStateId:                        bucket_name,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+				ImportStateVerify:                    true,
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/bucket_tags.tf"),
+				ConfigVariables: variables(map[string]string{"env": "prod"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.%", "1"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.env", "prod"),
+				),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/bucket_tags.tf"),
+				ConfigVariables: variables(map[string]string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func addBucketTag(bucket, key, value string) {
+	ctx := context.TODO()
+	client := testAccClient(ctx)
+	output, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: &bucket})
+	if err != nil {
+		panic(fmt.Sprintf("failed to get bucket tags: %v", err))
+	}
+
+	tags := tagsFromS3(output.TagSet)
+	tags[key] = value
+	_, err = client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  &bucket,
+		Tagging: &s3_types.Tagging{TagSet: tagsToS3(tags)},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to put bucket tags: %v", err))
+	}
+}
+
+func checkBucketHasTag(bucket, key, value string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.TODO()
+		client := testAccClient(ctx)
+		output, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: &bucket})
+		if err != nil {
+			return fmt.Errorf("failed to get bucket tags: %w", err)
+		}
+
+		if actual, ok := tagsFromS3(output.TagSet)[key]; !ok || actual != value {
+			return fmt.Errorf(`expected bucket %s to have tag %s with value "%s", got "%s"`, bucket, key, value, actual)
+		}
+		return nil
+	}
+}
+
+func TestAccBucketResource_defaultTags(t *testing.T) {
+	bucket_name := withSuffix("bucket-default-tags")
+	variables := func(defaultTags, tags map[string]string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"bucket_name":  config.StringVariable(bucket_name),
+			"default_tags": stringMapVariable(defaultTags),
+			"tags":         stringMapVariable(tags),
+		}
+	}
+
+	// Provider factories are defined on step level, because the configuration file contains a provider block.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/bucket_default_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "test", "team": "objsto"}, map[string]string{"app": "web"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.%", "1"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.app", "web"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.%", "3"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.env", "test"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.team", "objsto"),
+				),
+			},
+			{
+				// Tags matching ignore_tags should not cause a diff and should not be removed on update.
+				PreConfig:                func() { addBucketTag(bucket_name, "ignored:owner", "backend") },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/bucket_default_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "test", "team": "objsto"}, map[string]string{"app": "web"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.%", "3"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/bucket_default_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "prod"}, map[string]string{"app": "web", "team": "override"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags.%", "2"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.%", "3"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.env", "prod"),
+					resource.TestCheckResourceAttr("objsto_bucket.this", "tags_all.team", "override"),
+					checkBucketHasTag(bucket_name, "ignored:owner", "backend"),
+				),
+			},
+		},
+	})
+}
+
+func TestBucketResource_readWithoutTaggingSupport(t *testing.T) {
+	var taggingRequests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("tagging") {
+			taggingRequests++
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotImplemented)
+			_, _ = w.Write([]byte(`<Error><Code>NotImplemented</Code><Message>Tagging is not supported.</Message></Error>`))
+		}
+	})
+
+	r := &BucketResource{client: client}
+	s, nullTimeouts := getResourceSchema(t, r)
+
+	tests := []struct {
+		name                    string
+		tags                    types.Map
+		tagsAll                 types.Map
+		expectedTaggingRequests int
+	}{
+		{
+			name:    "Tags not configured",
+			tags:    types.MapNull(types.StringType),
+			tagsAll: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		{
+			name:    "Upgraded from version without tags",
+			tags:    types.MapNull(types.StringType),
+			tagsAll: types.MapNull(types.StringType),
+		},
+		{
+			name: "Tags configured",
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("test"),
+			}),
+			tagsAll: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("test"),
+			}),
+			expectedTaggingRequests: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			taggingRequests = 0
+
+			state := tfsdk.State{Schema: s}
+			assert.False(t, state.Set(ctx, BucketResourceModel{
+				Name:         types.StringValue("bucket"),
+				ARN:          types.StringValue(bucketARN("bucket")),
+				ForceDestroy: types.BoolValue(false),
+				Tags:         test.tags,
+				TagsAll:      test.tagsAll,
+				Timeouts:     nullTimeouts,
+			}).HasError())
+
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, test.expectedTaggingRequests, taggingRequests)
+		})
+	}
+
+	t.Run("Import", func(t *testing.T) {
+		ctx := t.Context()
+		taggingRequests = 0
+
+		resp := fwresource.ImportStateResponse{
+			State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: "bucket"}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, 1, taggingRequests)
+	})
+}
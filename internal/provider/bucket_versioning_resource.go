@@ -7,6 +7,7 @@ import (
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -34,8 +35,9 @@ type BucketVersioningResource struct {
 
 // BucketVersioningResourceModel describes the resource data model.
 type BucketVersioningResourceModel struct {
-	Bucket                  types.String `tfsdk:"bucket"`
-	VersioningConfiguration types.Object `tfsdk:"versioning_configuration"`
+	Bucket                  types.String   `tfsdk:"bucket"`
+	VersioningConfiguration types.Object   `tfsdk:"versioning_configuration"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 type VersioningConfiguration struct {
@@ -75,6 +77,12 @@ func (r *BucketVersioningResource) Schema(ctx context.Context, req resource.Sche
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -118,6 +126,9 @@ func (r *BucketVersioningResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -130,6 +141,9 @@ func (r *BucketVersioningResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	output, err := r.client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 	})
@@ -155,6 +169,9 @@ func (r *BucketVersioningResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultTimeout, "update", &resp.Diagnostics)
+	defer done()
+
 	resp.Diagnostics.Append(r.put(ctx, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -167,6 +184,9 @@ func (r *BucketVersioningResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 		VersioningConfiguration: &s3_types.VersioningConfiguration{
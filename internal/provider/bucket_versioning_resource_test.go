@@ -25,34 +25,9 @@ func deleteObjectsStep(bucket, versioning string) resource.TestStep {
 		ConfigVariables: getVariables(bucket, versioning, ""),
 		PreConfig: func() {
 			ctx := context.TODO()
-			client := getClient(ctx, ObjStoProviderModel{})
-			objVersions, err := client.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{
-				Bucket: &bucket,
-			})
-			if err != nil {
-				panic(fmt.Sprintf("failed to list object versions: %v", err))
-			}
-
-			for _, version := range objVersions.Versions {
-				_, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{
-					Bucket:    &bucket,
-					Key:       version.Key,
-					VersionId: version.VersionId,
-				})
-				if err != nil {
-					panic(fmt.Sprintf("failed to delete object version: %v", err))
-				}
-			}
-
-			for _, marker := range objVersions.DeleteMarkers {
-				_, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{
-					Bucket:    &bucket,
-					Key:       marker.Key,
-					VersionId: marker.VersionId,
-				})
-				if err != nil {
-					panic(fmt.Sprintf("failed to delete object delete marker: %v", err))
-				}
+			client := testAccClient(ctx)
+			if err := emptyBucket(ctx, client, bucket); err != nil {
+				panic(fmt.Sprintf("failed to empty bucket: %v", err))
 			}
 		},
 	}
@@ -109,7 +84,7 @@ func checkStringDoesChange(name, key string, expected *string) resource.TestChec
 func checkVersioningIsSuspended(bucket string) resource.TestCheckFunc {
 	return func(_ *tftest.State) error {
 		ctx := context.TODO()
-		client := getClient(ctx, ObjStoProviderModel{})
+		client := testAccClient(ctx)
 		versioning, err := client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
 			Bucket: &bucket,
 		})
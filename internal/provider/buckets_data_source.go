@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketsDataSource{}
+
+func NewBucketsDataSource() datasource.DataSource {
+	return &BucketsDataSource{}
+}
+
+// BucketsDataSource defines the data source implementation.
+type BucketsDataSource struct {
+	client *s3.Client
+}
+
+// BucketsDataSourceModel describes the data source data model.
+type BucketsDataSourceModel struct {
+	Prefix    types.String `tfsdk:"prefix"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Names     types.List   `tfsdk:"names"`
+	Buckets   types.List   `tfsdk:"buckets"`
+}
+
+type BucketsDataSourceBucket struct {
+	Name         types.String `tfsdk:"name"`
+	ARN          types.String `tfsdk:"arn"`
+	CreationDate types.String `tfsdk:"creation_date"`
+}
+
+func (m BucketsDataSourceBucket) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":          types.StringType,
+		"arn":           types.StringType,
+		"creation_date": types.StringType,
+	}
+}
+
+func (d *BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_buckets"
+}
+
+func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A buckets data source that lists the buckets visible to the configured credentials.",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include buckets with names that start with this prefix.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include buckets with names that match this regular expression.",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The names of the buckets.",
+				ElementType:         types.StringType,
+			},
+			"buckets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The buckets.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the bucket.",
+						},
+						"arn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ARN of the bucket.",
+						},
+						"creation_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The creation date of the bucket in RFC3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = getClientFromProviderData(req.ProviderData)
+}
+
+func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BucketsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	input := &s3.ListBucketsInput{}
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		input.Prefix = &prefix
+	}
+
+	names := []string{}
+	buckets := []BucketsDataSourceBucket{}
+	paginator := s3.NewListBucketsPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list buckets", err.Error())
+			return
+		}
+
+		for _, bucket := range page.Buckets {
+			name := aws.ToString(bucket.Name)
+			if name == "" {
+				continue
+			}
+
+			// Not all object storage services support filtering by prefix, so filter the names also here.
+			if !strings.HasPrefix(name, data.Prefix.ValueString()) {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			names = append(names, name)
+			buckets = append(buckets, BucketsDataSourceBucket{
+				Name:         types.StringValue(name),
+				ARN:          types.StringValue(bucketARN(name)),
+				CreationDate: formatTime(bucket.CreationDate),
+			})
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+
+	data.Buckets, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: BucketsDataSourceBucket{}.AttributeTypes()}, buckets)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBucketsDataSource(t *testing.T) {
+	bucket_name := withSuffix("buckets-data-source")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/buckets_data_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_buckets.prefix", "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.objsto_buckets.prefix", "names.*", bucket_name+"-a"),
+					resource.TestCheckTypeSetElemAttr("data.objsto_buckets.prefix", "names.*", bucket_name+"-b"),
+					resource.TestCheckResourceAttr("data.objsto_buckets.regex", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.objsto_buckets.regex", "buckets.0.name", bucket_name+"-a"),
+					resource.TestCheckResourceAttr("data.objsto_buckets.regex", "buckets.0.arn", "arn:aws:s3:::"+bucket_name+"-a"),
+					resource.TestCheckResourceAttrSet("data.objsto_buckets.regex", "buckets.0.creation_date"),
+				),
+			},
+		},
+	})
+}
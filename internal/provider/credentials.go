@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              types.String `tfsdk:"role_arn"`
+	WebIdentityToken     types.String `tfsdk:"web_identity_token"`
+	WebIdentityTokenFile types.String `tfsdk:"web_identity_token_file"`
+	SessionName          types.String `tfsdk:"session_name"`
+	Duration             types.String `tfsdk:"duration"`
+	STSEndpoint          types.String `tfsdk:"sts_endpoint"`
+}
+
+type AssumeRole struct {
+	RoleARN     types.String `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+	Duration    types.String `tfsdk:"duration"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	STSEndpoint types.String `tfsdk:"sts_endpoint"`
+}
+
+func assumeRoleBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Assume a role with the STS API of the object storage service and use the temporary credentials of the role. The credentials configured for the provider are used to assume the role. The temporary credentials are refreshed automatically before they expire.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ARN of the role to assume. Required when `assume_role` block is defined.",
+			},
+			"session_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the role session. Defaults to a name generated by the AWS SDK.",
+			},
+			"duration": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The duration of the role session, e.g., `1h`. Defaults to 15 minutes.",
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The external ID to use when assuming the role.",
+			},
+			"sts_endpoint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The STS endpoint to use when assuming the role. Defaults to the S3 endpoint of the provider.",
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRelative().AtName("role_arn")),
+		},
+	}
+}
+
+func assumeRoleWithWebIdentityBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Assume a role with a web identity token, e.g., an OIDC token issued to a CI pipeline, using the STS API of the object storage service and use the temporary credentials of the role. The temporary credentials are refreshed automatically before they expire.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ARN of the role to assume. Required when `assume_role_with_web_identity` block is defined.",
+			},
+			"web_identity_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The web identity token to exchange for temporary credentials. Conflicts with `web_identity_token_file`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("web_identity_token_file")),
+				},
+			},
+			"web_identity_token_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file that contains the web identity token. The file is read again each time the credentials are refreshed. Conflicts with `web_identity_token`.",
+			},
+			"session_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the role session. Defaults to a name generated by the AWS SDK.",
+			},
+			"duration": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The duration of the role session, e.g., `1h`. Defaults to 1 hour.",
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"sts_endpoint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The STS endpoint to use when assuming the role. Defaults to the S3 endpoint of the provider.",
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRelative().AtName("role_arn")),
+			objectvalidator.ConflictsWith(path.MatchRoot("assume_role")),
+		},
+	}
+}
+
+// getSTSClient returns a STS client that uses the endpoint, if defined, and otherwise the S3 endpoint of the provider.
+func getSTSClient(cfg aws.Config, endpoint types.String, s3Endpoint string) *sts.Client {
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(withStringDefault(endpoint, s3Endpoint))
+	})
+}
+
+func getAssumeRoleCredentials(ctx context.Context, cfg aws.Config, data types.Object, s3Endpoint string) (aws.CredentialsProvider, diag.Diagnostics) {
+	var assumeRole AssumeRole
+	diags := data.As(ctx, &assumeRole, objectAsOptions)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	client := getSTSClient(cfg, assumeRole.STSEndpoint, s3Endpoint)
+	provider := stscreds.NewAssumeRoleProvider(client, assumeRole.RoleARN.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+		if !assumeRole.SessionName.IsNull() {
+			o.RoleSessionName = assumeRole.SessionName.ValueString()
+		}
+		if !assumeRole.Duration.IsNull() {
+			// The duration is validated in the schema.
+			o.Duration, _ = time.ParseDuration(assumeRole.Duration.ValueString())
+		}
+		o.ExternalID = assumeRole.ExternalID.ValueStringPointer()
+	})
+	return aws.NewCredentialsCache(provider), diags
+}
+
+// webIdentityToken implements stscreds.IdentityTokenRetriever for a token defined in the configuration.
+type webIdentityToken string
+
+func (t webIdentityToken) GetIdentityToken() ([]byte, error) {
+	return []byte(t), nil
+}
+
+func getAssumeRoleWithWebIdentityCredentials(ctx context.Context, cfg aws.Config, data types.Object, s3Endpoint string) (aws.CredentialsProvider, diag.Diagnostics) {
+	var assumeRole AssumeRoleWithWebIdentity
+	diags := data.As(ctx, &assumeRole, objectAsOptions)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var token stscreds.IdentityTokenRetriever
+	switch {
+	case !assumeRole.WebIdentityToken.IsNull():
+		token = webIdentityToken(assumeRole.WebIdentityToken.ValueString())
+	case !assumeRole.WebIdentityTokenFile.IsNull():
+		token = stscreds.IdentityTokenFile(expandHomeDir(assumeRole.WebIdentityTokenFile.ValueString()))
+	default:
+		diags.AddAttributeError(
+			path.Root("assume_role_with_web_identity").AtName("web_identity_token"),
+			"Missing web identity token",
+			"Either web_identity_token or web_identity_token_file must be defined when assume_role_with_web_identity block is defined.",
+		)
+		return nil, diags
+	}
+
+	client := getSTSClient(cfg, assumeRole.STSEndpoint, s3Endpoint)
+	provider := stscreds.NewWebIdentityRoleProvider(client, assumeRole.RoleARN.ValueString(), token, func(o *stscreds.WebIdentityRoleOptions) {
+		if !assumeRole.SessionName.IsNull() {
+			o.RoleSessionName = assumeRole.SessionName.ValueString()
+		}
+		if !assumeRole.Duration.IsNull() {
+			// The duration is validated in the schema.
+			o.Duration, _ = time.ParseDuration(assumeRole.Duration.ValueString())
+		}
+	})
+	return aws.NewCredentialsCache(provider), diags
+}
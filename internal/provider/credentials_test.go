@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const stsCredentialsResponse = `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%[1]sResult>
+    <Credentials>
+      <AccessKeyId>temporary_access_key</AccessKeyId>
+      <SecretAccessKey>temporary_secret_key</SecretAccessKey>
+      <SessionToken>temporary_token</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </%[1]sResult>
+</%[1]sResponse>`
+
+// newSTSServer returns a STS stand-in that responds with temporary credentials and stores the received form values to requests.
+func newSTSServer(t *testing.T, requests *[]url.Values) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*requests = append(*requests, r.PostForm)
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, stsCredentialsResponse, r.PostForm.Get("Action"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetClient_token(t *testing.T) {
+	clearClientEnv(t)
+	t.Setenv(envKeyToken, "env_token")
+	ctx := t.Context()
+
+	client, diags := getClient(ctx, ObjStoProviderModel{
+		Endpoint:  types.StringValue("http://localhost:9000"),
+		Region:    types.StringValue("localhost"),
+		AccessKey: types.StringValue("access_key"),
+		SecretKey: types.StringValue("secret_key"),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	creds, err := client.Options().Credentials.Retrieve(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "env_token", creds.SessionToken)
+}
+
+func TestGetClient_assumeRole(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	var requests []url.Values
+	server := newSTSServer(t, &requests)
+
+	client, diags := getClient(ctx, ObjStoProviderModel{
+		Endpoint:  types.StringValue("http://localhost:9000"),
+		Region:    types.StringValue("localhost"),
+		AccessKey: types.StringValue("access_key"),
+		SecretKey: types.StringValue("secret_key"),
+		AssumeRole: types.ObjectValueMust(
+			map[string]attr.Type{
+				"role_arn":     types.StringType,
+				"session_name": types.StringType,
+				"duration":     types.StringType,
+				"external_id":  types.StringType,
+				"sts_endpoint": types.StringType,
+			},
+			map[string]attr.Value{
+				"role_arn":     types.StringValue("arn:aws:iam::123456789012:role/objsto"),
+				"session_name": types.StringValue("terraform"),
+				"duration":     types.StringValue("1h"),
+				"external_id":  types.StringValue("external"),
+				"sts_endpoint": types.StringValue(server.URL),
+			},
+		),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	creds, err := client.Options().Credentials.Retrieve(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "temporary_access_key", creds.AccessKeyID)
+	assert.Equal(t, "temporary_token", creds.SessionToken)
+
+	// Credentials are retrieved once during configuration and then cached.
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "AssumeRole", requests[0].Get("Action"))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/objsto", requests[0].Get("RoleArn"))
+	assert.Equal(t, "terraform", requests[0].Get("RoleSessionName"))
+	assert.Equal(t, "3600", requests[0].Get("DurationSeconds"))
+	assert.Equal(t, "external", requests[0].Get("ExternalId"))
+}
+
+func TestGetClient_assumeRoleWithWebIdentity(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	tokenFile := writeTestFile(t, "token", "file_token")
+	tests := []struct {
+		name          string
+		token         types.String
+		tokenFile     types.String
+		expectedToken string
+	}{
+		{
+			name:          "token",
+			token:         types.StringValue("config_token"),
+			tokenFile:     types.StringNull(),
+			expectedToken: "config_token",
+		},
+		{
+			name:          "token file",
+			token:         types.StringNull(),
+			tokenFile:     types.StringValue(tokenFile),
+			expectedToken: "file_token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests []url.Values
+			server := newSTSServer(t, &requests)
+
+			client, diags := getClient(ctx, ObjStoProviderModel{
+				Endpoint: types.StringValue("http://localhost:9000"),
+				Region:   types.StringValue("localhost"),
+				AssumeRoleWithWebIdentity: types.ObjectValueMust(
+					map[string]attr.Type{
+						"role_arn":                types.StringType,
+						"web_identity_token":      types.StringType,
+						"web_identity_token_file": types.StringType,
+						"session_name":            types.StringType,
+						"duration":                types.StringType,
+						"sts_endpoint":            types.StringType,
+					},
+					map[string]attr.Value{
+						"role_arn":                types.StringValue("arn:aws:iam::123456789012:role/ci"),
+						"web_identity_token":      test.token,
+						"web_identity_token_file": test.tokenFile,
+						"session_name":            types.StringValue("ci"),
+						"duration":                types.StringNull(),
+						"sts_endpoint":            types.StringValue(server.URL),
+					},
+				),
+			})
+			assert.False(t, diags.HasError(), diags)
+
+			creds, err := client.Options().Credentials.Retrieve(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, "temporary_access_key", creds.AccessKeyID)
+			assert.Equal(t, "temporary_token", creds.SessionToken)
+
+			assert.Len(t, requests, 1)
+			assert.Equal(t, "AssumeRoleWithWebIdentity", requests[0].Get("Action"))
+			assert.Equal(t, "arn:aws:iam::123456789012:role/ci", requests[0].Get("RoleArn"))
+			assert.Equal(t, "ci", requests[0].Get("RoleSessionName"))
+			assert.Equal(t, test.expectedToken, requests[0].Get("WebIdentityToken"))
+			// Without DurationSeconds, STS issues credentials that are valid for one hour.
+			assert.False(t, requests[0].Has("DurationSeconds"))
+		})
+	}
+}
+
+func TestGetClient_credentialProcess(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	script := writeTestFile(t, "credentials.sh", `#!/bin/sh
+echo '{"Version": 1, "AccessKeyId": "process_access_key", "SecretAccessKey": "process_secret_key", "SessionToken": "process_token", "Expiration": "2100-01-01T00:00:00Z"}'
+`)
+
+	client, diags := getClient(ctx, ObjStoProviderModel{
+		Endpoint:          types.StringValue("http://localhost:9000"),
+		Region:            types.StringValue("localhost"),
+		CredentialProcess: types.StringValue("sh " + script),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	creds, err := client.Options().Credentials.Retrieve(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "process_access_key", creds.AccessKeyID)
+	assert.Equal(t, "process_secret_key", creds.SecretAccessKey)
+	assert.Equal(t, "process_token", creds.SessionToken)
+	assert.True(t, creds.CanExpire)
+}
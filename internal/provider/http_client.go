@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// readPEM returns the value as is, if it contains PEM encoded data, and otherwise reads the PEM encoded data from the file in the given path.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(expandHomeDir(value))
+}
+
+func getTLSConfig(data ObjStoProviderModel) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if !data.CABundle.IsNull() {
+		bundle, err := readPEM(data.CABundle.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_bundle"), "Unable to read CA bundle", err.Error())
+			return nil, diags
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			diags.AddAttributeError(path.Root("ca_bundle"), "Invalid CA bundle", "CA bundle does not contain any PEM encoded certificates.")
+			return nil, diags
+		}
+		config.RootCAs = pool
+	}
+
+	if !data.ClientCertificate.IsNull() {
+		certificate, err := readPEM(data.ClientCertificate.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_certificate"), "Unable to read client certificate", err.Error())
+			return nil, diags
+		}
+
+		key, err := readPEM(data.ClientKey.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_key"), "Unable to read client key", err.Error())
+			return nil, diags
+		}
+
+		keyPair, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_certificate"), "Invalid client certificate or key", err.Error())
+			return nil, diags
+		}
+		config.Certificates = []tls.Certificate{keyPair}
+	}
+
+	config.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+
+	return config, diags
+}
+
+// proxySchemes contains the proxy URL schemes supported by the HTTP transport.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// validateProxyURL checks that the proxy URL includes a supported scheme and a host. url.Parse accepts, e.g., `proxy.example.com:3128` by interpreting the host name as the scheme.
+func validateProxyURL(value string) error {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(proxySchemes, proxyURL.Scheme) {
+		return fmt.Errorf("proxy URL %q must use one of the following schemes: %s, e.g., http://proxy.example.com:3128", value, strings.Join(proxySchemes, ", "))
+	}
+	if proxyURL.Host == "" {
+		return fmt.Errorf("proxy URL %q does not include a host", value)
+	}
+	return nil
+}
+
+// getProxyFunc returns the function that selects the proxy for the requests. Proxy settings that are not defined in the configuration are read from the environment variables.
+func getProxyFunc(data ObjStoProviderModel) (func(*http.Request) (*url.URL, error), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := httpproxy.FromEnvironment()
+	for _, proxy := range []struct {
+		attribute string
+		value     types.String
+		target    *string
+	}{
+		{"http_proxy", data.HTTPProxy, &config.HTTPProxy},
+		{"https_proxy", data.HTTPSProxy, &config.HTTPSProxy},
+	} {
+		if proxy.value.IsNull() {
+			continue
+		}
+		// Empty value is allowed for disabling the proxy defined in the environment variables.
+		if value := proxy.value.ValueString(); value != "" {
+			if err := validateProxyURL(value); err != nil {
+				diags.AddAttributeError(path.Root(proxy.attribute), "Invalid proxy URL", err.Error())
+			}
+		}
+		*proxy.target = proxy.value.ValueString()
+	}
+	if !data.NoProxy.IsNull() {
+		config.NoProxy = data.NoProxy.ValueString()
+	}
+
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, diags
+}
+
+// parseDuration parses a duration that has been validated in the schema. Null value is parsed as zero.
+func parseDuration(value types.String) time.Duration {
+	duration, _ := time.ParseDuration(value.ValueString())
+	return duration
+}
+
+// getHTTPClient returns the HTTP client to use in the API requests.
+func getHTTPClient(data ObjStoProviderModel) (*awshttp.BuildableClient, diag.Diagnostics) {
+	tlsConfig, diags := getTLSConfig(data)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	proxyFunc, d := getProxyFunc(data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	client := awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		tr.TLSClientConfig = tlsConfig
+		tr.Proxy = proxyFunc
+
+		if !data.MaxIdleConnsPerHost.IsNull() {
+			tr.MaxIdleConnsPerHost = int(data.MaxIdleConnsPerHost.ValueInt64())
+			tr.MaxIdleConns = max(tr.MaxIdleConns, tr.MaxIdleConnsPerHost)
+		}
+		if !data.IdleConnTimeout.IsNull() {
+			tr.IdleConnTimeout = parseDuration(data.IdleConnTimeout)
+		}
+		if !data.TLSHandshakeTimeout.IsNull() {
+			tr.TLSHandshakeTimeout = parseDuration(data.TLSHandshakeTimeout)
+		}
+		if data.DisableHTTP2.ValueBool() {
+			// Non-nil empty map disables the HTTP/2 support of the transport.
+			tr.ForceAttemptHTTP2 = false
+			tr.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+	})
+
+	if !data.DialTimeout.IsNull() {
+		client = client.WithDialerOptions(func(dialer *net.Dialer) {
+			dialer.Timeout = parseDuration(data.DialTimeout)
+		})
+	}
+
+	return client, diags
+}
@@ -1,13 +1,55 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	logModeNone    = "none"
+	logModeRetries = "retries"
+	logModeHeaders = "headers"
+	logModeBodies  = "bodies"
+)
+
+const (
+	requestHeaderFieldPrefix  = "request_header."
+	responseHeaderFieldPrefix = "response_header."
+)
+
+// maskedLogFields contains the log fields that may contain credentials or encryption keys.
+var maskedLogFields = []string{
+	requestHeaderFieldPrefix + "authorization",
+	requestHeaderFieldPrefix + "x-amz-security-token",
+	requestHeaderFieldPrefix + "x-amz-server-side-encryption-customer-key",
+	requestHeaderFieldPrefix + "x-amz-copy-source-server-side-encryption-customer-key",
+	responseHeaderFieldPrefix + "x-amz-security-token",
+}
+
+// payloadOperations contains the operations that transfer object content. Content of these operations is never logged.
+var payloadOperations = []string{
+	"GetObject",
+	"PutObject",
+	"UploadPart",
+}
+
+// withLogMasking configures the context to mask the sensitive log fields.
+func withLogMasking(ctx context.Context) context.Context {
+	return tflog.MaskFieldValuesWithFieldKeys(ctx, maskedLogFields...)
+}
+
 type logger struct {
 	ctx context.Context
 }
@@ -24,11 +66,144 @@ func (l logger) Logf(classification logging.Classification, format string, args
 		return
 	}
 
+	ctx := withLogMasking(l.ctx)
 	msg := fmt.Sprintf("S3 API "+format, args...)
 	switch classification {
 	case logging.Debug:
-		tflog.Debug(l.ctx, msg)
+		tflog.Debug(ctx, msg)
 	case logging.Warn:
-		tflog.Warn(l.ctx, msg)
+		tflog.Warn(ctx, msg)
+	}
+}
+
+// getClientLogMode returns the log mode of the SDK for the given provider log mode. Requests and responses are logged with the requestLogger middleware instead of the SDK.
+func getClientLogMode(mode string) aws.ClientLogMode {
+	if mode == logModeNone {
+		return 0
+	}
+	return aws.LogRetries
+}
+
+// getLogMiddlewares returns the API options that add the request logging middlewares into the operation stack.
+func getLogMiddlewares(mode string) []func(*middleware.Stack) error {
+	if mode != logModeHeaders && mode != logModeBodies {
+		return nil
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(logParameters{}, middleware.After)
+		},
+		func(stack *middleware.Stack) error {
+			return stack.Deserialize.Add(&requestLogger{bodies: mode == logModeBodies}, middleware.After)
+		},
+	}
+}
+
+type logParametersKey struct{}
+
+// logParameters stores the bucket and key of the operation input so that they can be logged with the request.
+type logParameters struct{}
+
+func (logParameters) ID() string {
+	return "ObjStoLogParameters"
+}
+
+func (logParameters) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	fields := map[string]interface{}{}
+
+	// The operation inputs do not share an interface for accessing the bucket and key, so read them with reflection.
+	input := reflect.Indirect(reflect.ValueOf(in.Parameters))
+	if input.Kind() == reflect.Struct {
+		for field, name := range map[string]string{"bucket": "Bucket", "key": "Key"} {
+			value := input.FieldByName(name)
+			if value.IsValid() && value.Type() == reflect.TypeFor[*string]() && !value.IsNil() {
+				fields[field] = value.Elem().String()
+			}
+		}
+	}
+
+	ctx = middleware.WithStackValue(ctx, logParametersKey{}, fields)
+	return next.HandleInitialize(ctx, in)
+}
+
+// requestLogger logs the requests sent to and the responses received from the object storage service as structured log entries.
+type requestLogger struct {
+	bodies bool
+}
+
+func (*requestLogger) ID() string {
+	return "ObjStoRequestLogger"
+}
+
+func (l *requestLogger) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return next.HandleDeserialize(ctx, in)
+	}
+
+	operation := middleware.GetOperationName(ctx)
+	logBodies := l.bodies && !slices.Contains(payloadOperations, operation)
+
+	fields := operationLogFields(ctx, operation)
+	fields["method"] = req.Method
+	fields["url"] = req.URL.String()
+	addHeaderFields(fields, requestHeaderFieldPrefix, req.Header)
+	if logBodies && req.IsStreamSeekable() {
+		if body, err := io.ReadAll(req.GetStream()); err == nil && len(body) > 0 {
+			fields["request_body"] = string(body)
+		}
+		if err := req.RewindStream(); err != nil {
+			return middleware.DeserializeOutput{}, middleware.Metadata{}, err
+		}
+	}
+
+	ctx = withLogMasking(ctx)
+	tflog.Debug(ctx, "S3 API request", fields)
+
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+
+	resp, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok || resp == nil {
+		return out, metadata, err
+	}
+
+	fields = operationLogFields(ctx, operation)
+	fields["status"] = resp.StatusCode
+	fields["request_id"] = resp.Header.Get("X-Amz-Request-Id")
+	addHeaderFields(fields, responseHeaderFieldPrefix, resp.Header)
+	if logBodies && resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return out, metadata, readErr
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) > 0 {
+			fields["response_body"] = string(body)
+		}
+	}
+
+	tflog.Debug(ctx, "S3 API response", fields)
+	return out, metadata, err
+}
+
+// operationLogFields returns the log fields common to the request and response of the operation.
+func operationLogFields(ctx context.Context, operation string) map[string]interface{} {
+	fields := map[string]interface{}{
+		"operation": operation,
+	}
+	if parameters, ok := middleware.GetStackValue(ctx, logParametersKey{}).(map[string]interface{}); ok {
+		for k, v := range parameters {
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+// addHeaderFields adds each header as a separate field, so that the sensitive headers can be masked by the field key.
+func addHeaderFields(fields map[string]interface{}, prefix string, header http.Header) {
+	for name, values := range header {
+		fields[prefix+strings.ToLower(name)] = strings.Join(values, ", ")
 	}
 }
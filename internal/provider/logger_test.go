@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger(t *testing.T) {
+	clearClientEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amz-Request-Id", "request-id")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte("secret content"))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	const policy = `{"Version":"2012-10-17","Statement":[]}`
+
+	tests := []struct {
+		name            string
+		logMode         types.String
+		expectedEntries int
+		expectedBody    bool
+	}{
+		{
+			name:            "Default",
+			logMode:         types.StringNull(),
+			expectedEntries: 6,
+		},
+		{
+			name:            "None",
+			logMode:         types.StringValue(logModeNone),
+			expectedEntries: 0,
+		},
+		{
+			name:            "Retries",
+			logMode:         types.StringValue(logModeRetries),
+			expectedEntries: 0,
+		},
+		{
+			name:            "Bodies",
+			logMode:         types.StringValue(logModeBodies),
+			expectedEntries: 6,
+			expectedBody:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &output)
+
+			client, diags := getClient(ctx, ObjStoProviderModel{
+				Endpoint:  types.StringValue(server.URL),
+				Region:    types.StringValue("localhost"),
+				AccessKey: types.StringValue("access_key"),
+				SecretKey: types.StringValue("secret_key"),
+				Token:     types.StringValue("session_token"),
+				LogMode:   test.logMode,
+			})
+			assert.False(t, diags.HasError(), diags)
+
+			_, err := client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+				Bucket: aws.String("bucket"),
+				Policy: aws.String(policy),
+			})
+			assert.NoError(t, err)
+
+			_, err = client.PutObject(ctx, &s3.PutObjectInput{
+				Bucket: aws.String("bucket"),
+				Key:    aws.String("key"),
+				Body:   strings.NewReader("secret content"),
+			})
+			assert.NoError(t, err)
+
+			object, err := client.GetObject(ctx, &s3.GetObjectInput{
+				Bucket:               aws.String("bucket"),
+				Key:                  aws.String("key"),
+				SSECustomerAlgorithm: aws.String("AES256"),
+				SSECustomerKey:       aws.String("c2VjcmV0LWN1c3RvbWVyLWtleS0wMDAwMDAwMDAwMDA="),
+			})
+			if assert.NoError(t, err) {
+				object.Body.Close()
+			}
+
+			assert.NotContains(t, output.String(), "session_token")
+			assert.NotContains(t, output.String(), "c2VjcmV0LWN1c3RvbWVyLWtleS0wMDAwMDAwMDAwMDA=")
+			assert.NotContains(t, output.String(), "secret content")
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			assert.NoError(t, err)
+			assert.Len(t, entries, test.expectedEntries)
+
+			for _, entry := range entries {
+				assert.Equal(t, "bucket", entry["bucket"])
+				switch entry["@message"] {
+				case "S3 API request":
+					assert.Equal(t, "***", entry["request_header.authorization"])
+					assert.Equal(t, "***", entry["request_header.x-amz-security-token"])
+					if entry["operation"] == "PutBucketPolicy" {
+						assert.Equal(t, test.expectedBody, entry["request_body"] == policy)
+					}
+				case "S3 API response":
+					assert.Equal(t, "request-id", entry["request_id"])
+					assert.EqualValues(t, http.StatusOK, entry["status"])
+				default:
+					t.Errorf("unexpected log entry: %v", entry)
+				}
+				if entry["operation"] != "PutBucketPolicy" {
+					assert.Equal(t, "key", entry["key"])
+				}
+			}
+		})
+	}
+}
+
+func TestLogger_assumeRoleWithWebIdentity(t *testing.T) {
+	clearClientEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+	}))
+	t.Cleanup(server.Close)
+
+	var requests []url.Values
+	stsServer := newSTSServer(t, &requests)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	client, diags := getClient(ctx, ObjStoProviderModel{
+		Endpoint: types.StringValue(server.URL),
+		Region:   types.StringValue("localhost"),
+		LogMode:  types.StringValue(logModeBodies),
+		AssumeRoleWithWebIdentity: types.ObjectValueMust(
+			map[string]attr.Type{
+				"role_arn":                types.StringType,
+				"web_identity_token":      types.StringType,
+				"web_identity_token_file": types.StringType,
+				"session_name":            types.StringType,
+				"duration":                types.StringType,
+				"sts_endpoint":            types.StringType,
+			},
+			map[string]attr.Value{
+				"role_arn":                types.StringValue("arn:aws:iam::123456789012:role/ci"),
+				"web_identity_token":      types.StringValue("config_token"),
+				"web_identity_token_file": types.StringNull(),
+				"session_name":            types.StringValue("ci"),
+				"duration":                types.StringNull(),
+				"sts_endpoint":            types.StringValue(stsServer.URL),
+			},
+		),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	_, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+
+	assert.NotContains(t, output.String(), "config_token")
+	assert.NotContains(t, output.String(), "temporary_secret_key")
+	assert.NotContains(t, output.String(), "temporary_token")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Equal(t, "ListBuckets", entry["operation"])
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectDataSource{}
+
+func NewObjectDataSource() datasource.DataSource {
+	return &ObjectDataSource{}
+}
+
+// ObjectDataSource defines the data source implementation.
+type ObjectDataSource struct {
+	client *s3.Client
+	tags   tagsConfig
+}
+
+// ObjectDataSourceModel describes the data source data model.
+type ObjectDataSourceModel struct {
+	Bucket        types.String `tfsdk:"bucket"`
+	Key           types.String `tfsdk:"key"`
+	VersionID     types.String `tfsdk:"version_id"`
+	Body          types.String `tfsdk:"body"`
+	BodyBase64    types.String `tfsdk:"body_base64"`
+	ContentLength types.Int64  `tfsdk:"content_length"`
+	ContentType   types.String `tfsdk:"content_type"`
+	ETag          types.String `tfsdk:"etag"`
+	LastModified  types.String `tfsdk:"last_modified"`
+	Metadata      types.Map    `tfsdk:"metadata"`
+	Tags          types.Map    `tfsdk:"tags"`
+}
+
+func (d *ObjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object"
+}
+
+func (d *ObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An object data source that provides the content and metadata of an existing object stored in a bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket where the object is stored.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the object.",
+			},
+			"version_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The version ID of the object. If not set, the latest version of the object is read.",
+			},
+			"body": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The content of the object. This is only set if the content is valid UTF-8 text, use `body_base64` for binary content.",
+			},
+			"body_base64": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The content of the object encoded in base64.",
+			},
+			"content_length": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size of the object in bytes.",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The content type of the object.",
+			},
+			"etag": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The entity tag of the object.",
+			},
+			"last_modified": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last modification time of the object in RFC3339 format.",
+			},
+			"metadata": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user-defined metadata of the object.",
+				ElementType:         types.StringType,
+			},
+			"tags": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tags assigned to the object.",
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var data *objStoProviderData
+	data, resp.Diagnostics = getProviderData(req.ProviderData)
+	if data != nil {
+		d.client = data.client
+		d.tags = data.tags
+	}
+}
+
+func trimETag(etag *string) types.String {
+	if etag == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(strings.Trim(*etag, `"`))
+}
+
+func (d *ObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, body, diags := getObject(ctx, d.client, &s3.GetObjectInput{
+		Bucket:    data.Bucket.ValueStringPointer(),
+		Key:       data.Key.ValueStringPointer(),
+		VersionId: data.VersionID.ValueStringPointer(),
+	})
+	if output == nil && !diags.HasError() {
+		resp.Diagnostics.AddError("Object not found", fmt.Sprintf("Object %s does not exist in bucket %s", data.Key.ValueString(), data.Bucket.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Body = types.StringNull()
+	if utf8.Valid(body) {
+		data.Body = types.StringValue(string(body))
+	}
+	data.BodyBase64 = types.StringValue(base64.StdEncoding.EncodeToString(body))
+	data.ContentLength = types.Int64Value(int64(len(body)))
+	data.ContentType = types.StringPointerValue(output.ContentType)
+	data.ETag = trimETag(output.ETag)
+	data.VersionID = types.StringPointerValue(output.VersionId)
+
+	data.LastModified = formatTime(output.LastModified)
+
+	metadata := output.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, metadata)
+	resp.Diagnostics.Append(diags...)
+
+	tags, err := getObjectTags(ctx, d.client, data.Bucket.ValueString(), data.Key.ValueString(), output.VersionId)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read object tags", err.Error())
+		return
+	}
+
+	data.Tags, diags = types.MapValueFrom(ctx, types.StringType, d.tags.withoutIgnored(tags))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
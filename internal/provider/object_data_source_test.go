@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectDataSource(t *testing.T) {
+	bucket_name := withSuffix("object-data-source")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+	content := `{"message":"Hello objsto!"}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/object_data_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_object.this", "body", content),
+					resource.TestCheckResourceAttr("data.objsto_object.this", "body_base64", base64.StdEncoding.EncodeToString([]byte(content))),
+					resource.TestCheckResourceAttr("data.objsto_object.this", "content_length", "27"),
+					resource.TestCheckResourceAttrSet("data.objsto_object.this", "content_type"),
+					resource.TestCheckResourceAttrSet("data.objsto_object.this", "etag"),
+					resource.TestCheckResourceAttrSet("data.objsto_object.this", "last_modified"),
+					resource.TestCheckResourceAttr("data.objsto_object.this", "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
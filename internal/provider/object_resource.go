@@ -1,26 +1,42 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ObjectResource{}
 var _ resource.ResourceWithImportState = &ObjectResource{}
+var _ resource.ResourceWithModifyPlan = &ObjectResource{}
 
 func NewObjectResource() resource.Resource {
 	return &ObjectResource{}
@@ -29,16 +45,34 @@ func NewObjectResource() resource.Resource {
 // ObjectResource defines the resource implementation.
 type ObjectResource struct {
 	client *s3.Client
+	tags   tagsConfig
 }
 
 // ObjectResourceModel describes the resource data model.
 type ObjectResourceModel struct {
-	Bucket    types.String `tfsdk:"bucket"`
-	Id        types.String `tfsdk:"id"`
-	Key       types.String `tfsdk:"key"`
-	Content   types.String `tfsdk:"content"`
-	URL       types.String `tfsdk:"url"`
-	VersionID types.String `tfsdk:"version_id"`
+	Bucket        types.String `tfsdk:"bucket"`
+	Id            types.String `tfsdk:"id"`
+	Key           types.String `tfsdk:"key"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Source        types.String `tfsdk:"source"`
+	SourceHash    types.String `tfsdk:"source_hash"`
+
+	ContentType        types.String `tfsdk:"content_type"`
+	CacheControl       types.String `tfsdk:"cache_control"`
+	ContentDisposition types.String `tfsdk:"content_disposition"`
+	ContentEncoding    types.String `tfsdk:"content_encoding"`
+	ContentLanguage    types.String `tfsdk:"content_language"`
+	Expires            types.String `tfsdk:"expires"`
+	Metadata           types.Map    `tfsdk:"metadata"`
+
+	Tags    types.Map `tfsdk:"tags"`
+	TagsAll types.Map `tfsdk:"tags_all"`
+
+	ETag      types.String   `tfsdk:"etag"`
+	URL       types.String   `tfsdk:"url"`
+	VersionID types.String   `tfsdk:"version_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,8 +107,77 @@ func (r *ObjectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"content": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The content of the object.",
+				Optional:            true,
+				MarkdownDescription: "The content of the object as UTF-8 text. Exactly one of `content`, `content_base64`, and `source` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content_base64"), path.MatchRoot("source")),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The content of the object encoded in base64. Use this instead of `content` for binary content, such as images or compressed archives.",
+				Validators: []validator.String{
+					isValidBase64{},
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local file to upload as the content of the object. The file is streamed to the object storage service without loading it into the state. Changes to the file content are not detected from the path, use `source_hash` to trigger updates when the file changes. The `create` and `update` timeouts default to 30 minutes, increase them in the `timeouts` block when uploading large files over slow connections.",
+			},
+			"source_hash": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Hash of the file defined in `source`, e.g., `filemd5(\"path/to/file\")`. The object is uploaded again when the value changes.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source")),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The media type of the object, e.g., `application/json`. If not set, the media type is detected from the extension of the `key` when the object is created, and defaults to `application/octet-stream`.",
+			},
+			"cache_control": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The caching behavior of the object, e.g., `max-age=3600`, returned in the `Cache-Control` header.",
+			},
+			"content_disposition": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The presentation information of the object, e.g., `attachment; filename=\"report.pdf\"`, returned in the `Content-Disposition` header.",
+			},
+			"content_encoding": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The encodings applied to the content of the object, e.g., `gzip`, returned in the `Content-Encoding` header.",
+			},
+			"content_language": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The language of the content of the object, e.g., `en-US`, returned in the `Content-Language` header.",
+			},
+			"expires": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date and time in RFC3339 format after which the object is no longer cacheable, returned in the `Expires` header.",
+				Validators: []validator.String{
+					isValidRFC3339{},
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: "The user-defined metadata of the object, stored as `x-amz-meta-*` headers. The object storage service stores the keys in lower case, but the case of the configured keys is preserved in the state. Changes to the metadata or the HTTP headers are applied by copying the object onto itself, without uploading the content again.",
+				ElementType:         types.StringType,
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: "The tags to assign to the object. An object can have at most 10 tags, including the tags inherited from the provider `default_tags` configuration.",
+				ElementType:         types.StringType,
+				Validators:          objectTagsValidators(),
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "All tags assigned to the object, including the tags inherited from the provider `default_tags` configuration.",
+				ElementType:         types.StringType,
+			},
+			"etag": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The entity tag of the object.",
 			},
 			"url": schema.StringAttribute{
 				Computed:            true,
@@ -88,36 +191,255 @@ func (r *ObjectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The version ID of the object. This is only set if the bucket has versioning enabled.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 func (r *ObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client, resp.Diagnostics = getClientFromProviderData(req.ProviderData)
+	var data *objStoProviderData
+	data, resp.Diagnostics = getProviderData(req.ProviderData)
+	if data != nil {
+		r.client = data.client
+		r.tags = data.tags
+	}
 }
 
-func (r *ObjectResource) put(ctx context.Context, data *ObjectResourceModel) (diags diag.Diagnostics) {
-	body := strings.NewReader(data.Content.ValueString())
+// put uploads the object with the given tags. The tags are sent in the same request, so that the object is never left without its tags.
+func (r *ObjectResource) put(ctx context.Context, data *ObjectResourceModel, tags map[string]string) (diags diag.Diagnostics) {
+	var body io.Reader
+	switch {
+	case !data.ContentBase64.IsNull():
+		content, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("content_base64"), "Invalid base64 content", err.Error())
+			return
+		}
+		body = bytes.NewReader(content)
+	case !data.Source.IsNull():
+		// The file is seekable, so the SDK can calculate the content length and checksums without reading the file into memory.
+		file, err := os.Open(expandHomeDir(data.Source.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("source"), "Unable to open source file", err.Error())
+			return
+		}
+		defer file.Close()
+		body = file
+	default:
+		body = strings.NewReader(data.Content.ValueString())
+	}
+
+	if data.ContentType.IsUnknown() {
+		data.ContentType = types.StringValue(detectContentType(data.Key.ValueString()))
+	}
+
+	metadata := map[string]string{}
+	diags.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+	if diags.HasError() {
+		return
+	}
+
 	output, err := r.client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket: data.Bucket.ValueStringPointer(),
-		Key:    data.Key.ValueStringPointer(),
-		Body:   body,
+		Bucket:             data.Bucket.ValueStringPointer(),
+		Key:                data.Key.ValueStringPointer(),
+		Body:               body,
+		ContentType:        data.ContentType.ValueStringPointer(),
+		CacheControl:       data.CacheControl.ValueStringPointer(),
+		ContentDisposition: data.ContentDisposition.ValueStringPointer(),
+		ContentEncoding:    data.ContentEncoding.ValueStringPointer(),
+		ContentLanguage:    data.ContentLanguage.ValueStringPointer(),
+		Expires:            parseExpires(data.Expires),
+		Metadata:           metadata,
+		Tagging:            encodeTags(tags),
 	})
 	if err != nil {
 		diags.AddError("Unable to create object", err.Error())
+		return
 	}
 	if output.VersionId == nil {
 		data.VersionID = types.StringNull()
 	} else {
 		data.VersionID = types.StringValue(*output.VersionId)
 	}
+	data.ETag = trimETag(output.ETag)
+	return
+}
+
+// replaceMetadata replaces the metadata and HTTP headers of the object by copying the object onto itself, so that the content does not need to be uploaded again. The tags are replaced with the given tags, or kept as is if tags is nil.
+func (r *ObjectResource) replaceMetadata(ctx context.Context, data *ObjectResourceModel, tags map[string]string) (diags diag.Diagnostics) {
+	metadata := map[string]string{}
+	diags.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:             data.Bucket.ValueStringPointer(),
+		Key:                data.Key.ValueStringPointer(),
+		CopySource:         aws.String(copySource(data.Bucket.ValueString(), data.Key.ValueString())),
+		MetadataDirective:  s3_types.MetadataDirectiveReplace,
+		ContentType:        data.ContentType.ValueStringPointer(),
+		CacheControl:       data.CacheControl.ValueStringPointer(),
+		ContentDisposition: data.ContentDisposition.ValueStringPointer(),
+		ContentEncoding:    data.ContentEncoding.ValueStringPointer(),
+		ContentLanguage:    data.ContentLanguage.ValueStringPointer(),
+		Expires:            parseExpires(data.Expires),
+		Metadata:           metadata,
+	}
+	if tags != nil {
+		input.TaggingDirective = s3_types.TaggingDirectiveReplace
+		input.Tagging = encodeTags(tags)
+	}
+
+	output, err := r.client.CopyObject(ctx, input)
+	if err != nil {
+		diags.AddError("Unable to update object metadata", err.Error())
+		return
+	}
+	data.VersionID = types.StringPointerValue(output.VersionId)
+	if output.CopyObjectResult != nil {
+		data.ETag = trimETag(output.CopyObjectResult.ETag)
+	}
 	return
 }
 
-func buildURL(endpoint, bucket, key string) string {
+// copySource returns the URL-encoded copy source of the object.
+func copySource(bucket, key string) string {
+	return bucket + "/" + strings.ReplaceAll(url.PathEscape(key), "%2F", "/")
+}
+
+// metadataToMap converts the metadata into a map value. The object storage service returns the keys in lower case, so the case of the keys in the current value is preserved.
+func metadataToMap(ctx context.Context, metadata map[string]string, current types.Map) (types.Map, diag.Diagnostics) {
+	if len(metadata) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+
+	keys := make(map[string]string, len(current.Elements()))
+	for key := range current.Elements() {
+		keys[strings.ToLower(key)] = key
+	}
+
+	normalized := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if currentKey, ok := keys[strings.ToLower(key)]; ok {
+			key = currentKey
+		}
+		normalized[key] = value
+	}
+	return types.MapValueFrom(ctx, types.StringType, normalized)
+}
+
+// detectContentType returns the media type matching the extension of the key.
+func detectContentType(key string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(key)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// parseExpires parses the expires value that has been validated in the schema.
+func parseExpires(value types.String) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	expires, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil
+	}
+	return &expires
+}
+
+// objectHeaders contains the standard HTTP headers of an object returned by GetObject and HeadObject.
+type objectHeaders struct {
+	ContentType        *string
+	CacheControl       *string
+	ContentDisposition *string
+	ContentEncoding    *string
+	ContentLanguage    *string
+	Expires            *string
+}
+
+func setObjectHeaders(data *ObjectResourceModel, headers objectHeaders) {
+	data.ContentType = types.StringPointerValue(headers.ContentType)
+	data.CacheControl = types.StringPointerValue(headers.CacheControl)
+	data.ContentDisposition = types.StringPointerValue(headers.ContentDisposition)
+	data.ContentEncoding = types.StringPointerValue(headers.ContentEncoding)
+	data.ContentLanguage = types.StringPointerValue(headers.ContentLanguage)
+
+	if headers.Expires == nil {
+		data.Expires = types.StringNull()
+		return
+	}
+	expires, err := http.ParseTime(*headers.Expires)
+	if err != nil {
+		data.Expires = types.StringValue(*headers.Expires)
+		return
+	}
+	// Keep the current value, if it represents the same time, to avoid diffs caused by the time zone or format of the header.
+	if current := parseExpires(data.Expires); current == nil || !current.Equal(expires) {
+		data.Expires = formatTime(&expires)
+	}
+}
+
+// buildBucketURL returns the URL of the bucket. Virtual-hosted-style URL is used, if path-style addressing is disabled in the client options.
+func buildBucketURL(options s3.Options, bucket string) string {
+	endpoint := aws.ToString(options.BaseEndpoint)
+	if !options.UsePathStyle {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			u.Host = bucket + "." + u.Host
+			return strings.TrimSuffix(u.String(), "/")
+		}
+	}
+
 	if !strings.HasSuffix(endpoint, "/") {
 		endpoint += "/"
 	}
-	return fmt.Sprintf("%s%s/%s", endpoint, bucket, key)
+	return fmt.Sprintf("%s%s", endpoint, bucket)
+}
+
+func buildURL(options s3.Options, bucket, key string) string {
+	return fmt.Sprintf("%s/%s", buildBucketURL(options, bucket), key)
+}
+
+func (r *ObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.tags.modifyPlanTagsAll(ctx, req, resp)
+	resp.Diagnostics.Append(validateObjectTagsAll(ctx, resp.Plan)...)
+
+	var contentType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_type"), &contentType)...)
+	if resp.Diagnostics.HasError() || !contentType.IsNull() {
+		return
+	}
+
+	var key types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key"), &key)...)
+	if resp.Diagnostics.HasError() || key.IsUnknown() {
+		return
+	}
+
+	// Keep the current media type of an existing object, and detect the media type of a new object from the key.
+	if !req.State.Raw.IsNull() {
+		var state ObjectResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.Key.Equal(key) {
+			contentType = state.ContentType
+		}
+	}
+	if contentType.IsNull() {
+		contentType = types.StringValue(detectContentType(key.ValueString()))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_type"), contentType)...)
 }
 
 func (r *ObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -128,9 +450,22 @@ func (r *ObjectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultObjectUploadTimeout, "create", &resp.Diagnostics)
+	defer done()
+
 	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Bucket.ValueString(), data.Key.ValueString()))
-	data.URL = types.StringValue(buildURL(*r.client.Options().BaseEndpoint, data.Bucket.ValueString(), data.Key.ValueString()))
-	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	data.URL = types.StringValue(buildURL(r.client.Options(), data.Bucket.ValueString(), data.Key.ValueString()))
+	tagsAll, diags := tagsFromMap(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data, tagsAll)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -154,45 +489,165 @@ func (r *ObjectResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
 	err := parseId(&data)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to parse object id", err.Error())
 		return
 	}
 
-	output, err := r.client.GetObject(ctx, &s3.GetObjectInput{
-		Bucket: data.Bucket.ValueStringPointer(),
-		Key:    data.Key.ValueStringPointer(),
-	})
+	// Objects uploaded from a file are not downloaded, as the content is not stored in the state.
+	if !data.Source.IsNull() {
+		output, err := r.client.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: data.Bucket.ValueStringPointer(),
+			Key:    data.Key.ValueStringPointer(),
+		})
+		if err != nil {
+			var re *awshttp.ResponseError
+			if errors.As(err, &re) && re.HTTPStatusCode() == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Unable to read object", err.Error())
+			return
+		}
+
+		data.VersionID = types.StringPointerValue(output.VersionId)
+		data.ETag = trimETag(output.ETag)
+		setObjectHeaders(&data, objectHeaders{
+			ContentType:        output.ContentType,
+			CacheControl:       output.CacheControl,
+			ContentDisposition: output.ContentDisposition,
+			ContentEncoding:    output.ContentEncoding,
+			ContentLanguage:    output.ContentLanguage,
+			Expires:            output.ExpiresString,
+		})
+
+		var diags diag.Diagnostics
+		data.Metadata, diags = metadataToMap(ctx, output.Metadata, data.Metadata)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		output, body, diags := getObject(ctx, r.client, &s3.GetObjectInput{
+			Bucket: data.Bucket.ValueStringPointer(),
+			Key:    data.Key.ValueStringPointer(),
+		})
+		if output == nil && !diags.HasError() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.VersionID = types.StringPointerValue(output.VersionId)
+		data.ETag = trimETag(output.ETag)
+		setObjectHeaders(&data, objectHeaders{
+			ContentType:        output.ContentType,
+			CacheControl:       output.CacheControl,
+			ContentDisposition: output.ContentDisposition,
+			ContentEncoding:    output.ContentEncoding,
+			ContentLanguage:    output.ContentLanguage,
+			Expires:            output.ExpiresString,
+		})
+		data.Metadata, diags = metadataToMap(ctx, output.Metadata, data.Metadata)
+		resp.Diagnostics.Append(diags...)
+		// Use the same representation as the configuration. On import, the representation is chosen based on the content.
+		switch {
+		case !data.ContentBase64.IsNull():
+			data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(body))
+		case !data.Content.IsNull() || utf8.Valid(body):
+			data.Content = types.StringValue(string(body))
+		default:
+			data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(body))
+		}
+	}
+
+	tags := map[string]string{}
+	if r.tags.isManaged(data.Tags, data.TagsAll) {
+		tags, err = getObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read object tags", err.Error())
+			return
+		}
+	}
+
+	tagsAll := r.tags.withoutIgnored(tags)
+	var diags diag.Diagnostics
+	data.TagsAll, diags = types.MapValueFrom(ctx, types.StringType, tagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	configuredTags, diags := tagsFromMap(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	data.Tags, diags = tagsToMap(ctx, r.tags.resourceTags(tagsAll, configuredTags), data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	data.URL = types.StringValue(buildURL(r.client.Options(), data.Bucket.ValueString(), data.Key.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getObject gets the object and reads its content. Returns nil output without error diagnostics, if the object does not exist.
+func getObject(ctx context.Context, client *s3.Client, input *s3.GetObjectInput) (output *s3.GetObjectOutput, body []byte, diags diag.Diagnostics) {
+	output, err := client.GetObject(ctx, input)
 	if err != nil {
 		var re *awshttp.ResponseError
 		if errors.As(err, &re) && re.HTTPStatusCode() == 404 {
-			resp.State.RemoveResource(ctx)
-			return
+			return nil, nil, nil
 		}
-		resp.Diagnostics.AddError("Unable to read object", err.Error())
-		return
+		diags.AddError("Unable to read object", err.Error())
+		return nil, nil, diags
 	}
+	defer output.Body.Close()
 
-	if output.VersionId == nil {
-		data.VersionID = types.StringNull()
-	} else {
-		data.VersionID = types.StringValue(*output.VersionId)
+	body, err = io.ReadAll(output.Body)
+	if err == nil && output.ContentLength != nil && int64(len(body)) != *output.ContentLength {
+		err = fmt.Errorf("expected %d bytes, got %d", *output.ContentLength, len(body))
+	}
+	if err != nil {
+		diags.AddError("Unable to read object content", err.Error())
 	}
+	return
+}
 
-	buf := new(strings.Builder)
-	n, err := io.Copy(buf, output.Body)
-	if err != nil || n != *output.ContentLength {
-		if err == nil {
-			err = fmt.Errorf("expected %d bytes, got %d", *output.ContentLength, n)
+// getObjectTags returns the tags of the object. Objects without tags return empty tags instead of an error.
+func getObjectTags(ctx context.Context, client *s3.Client, bucket, key string, versionID *string) (map[string]string, error) {
+	output, err := client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+		Bucket:    &bucket,
+		Key:       &key,
+		VersionId: versionID,
+	})
+	if err != nil {
+		// Object storage services without tagging support can not have tags.
+		if isNoSuchTagSet(err) || isNotImplemented(err) {
+			return map[string]string{}, nil
 		}
-		resp.Diagnostics.AddError("Unable to read object content", err.Error())
-		return
+		return nil, err
+	}
+	return tagsFromS3(output.TagSet), nil
+}
+
+// putObjectTags replaces the tags of the object. Empty tags remove all tags from the object.
+func putObjectTags(ctx context.Context, client *s3.Client, bucket, key string, versionID *string, tags map[string]string) error {
+	if len(tags) == 0 {
+		_, err := client.DeleteObjectTagging(ctx, &s3.DeleteObjectTaggingInput{
+			Bucket:    &bucket,
+			Key:       &key,
+			VersionId: versionID,
+		})
+		return err
 	}
 
-	data.Content = types.StringValue(buf.String())
-	data.URL = types.StringValue(buildURL(*r.client.Options().BaseEndpoint, data.Bucket.ValueString(), data.Key.ValueString()))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	_, err := client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+		Bucket:    &bucket,
+		Key:       &key,
+		VersionId: versionID,
+		Tagging: &s3_types.Tagging{
+			TagSet: tagsToS3(tags),
+		},
+	})
+	return err
 }
 
 func (r *ObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -203,7 +658,71 @@ func (r *ObjectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	var state ObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultObjectUploadTimeout, "update", &resp.Diagnostics)
+	defer done()
+
+	contentChanged := !data.Content.Equal(state.Content) ||
+		!data.ContentBase64.Equal(state.ContentBase64) ||
+		!data.Source.Equal(state.Source) ||
+		!data.SourceHash.Equal(state.SourceHash)
+	metadataChanged := !data.ContentType.Equal(state.ContentType) ||
+		!data.CacheControl.Equal(state.CacheControl) ||
+		!data.ContentDisposition.Equal(state.ContentDisposition) ||
+		!data.ContentEncoding.Equal(state.ContentEncoding) ||
+		!data.ContentLanguage.Equal(state.ContentLanguage) ||
+		!data.Expires.Equal(state.Expires) ||
+		!data.Metadata.Equal(state.Metadata)
+
+	tagsAll, diags := tagsFromMap(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	stateTagsAll, diags := tagsFromMap(ctx, state.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	tagsChanged := !maps.Equal(tagsAll, stateTagsAll)
+
+	// Tags are replaced as a whole, so read the current tags to keep the ignored tags. The tags of objects without managed tags are kept as is when copying the object.
+	managed := r.tags.isManaged(data.Tags, state.TagsAll)
+	var currentTags map[string]string
+	if contentChanged || tagsChanged || (metadataChanged && managed) {
+		var err error
+		currentTags, err = getObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read object tags", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tags := r.tags.withIgnoredFrom(tagsAll, currentTags)
+
+	switch {
+	case contentChanged:
+		resp.Diagnostics.Append(r.put(ctx, &data, tags)...)
+	case metadataChanged:
+		if !managed {
+			tags = nil
+		}
+		resp.Diagnostics.Append(r.replaceMetadata(ctx, &data, tags)...)
+	default:
+		// Only tags or attributes that are not stored in the object, such as timeouts, changed.
+		data.ETag = state.ETag
+		data.VersionID = state.VersionID
+		if tagsChanged {
+			if err := putObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), nil, tags); err != nil {
+				resp.Diagnostics.AddError("Unable to put object tags", err.Error())
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -215,6 +734,9 @@ func (r *ObjectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
 	_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: data.Bucket.ValueStringPointer(),
 		Key:    data.Key.ValueStringPointer(),
@@ -226,4 +748,18 @@ func (r *ObjectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *ObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	data := ObjectResourceModel{Id: types.StringValue(req.ID)}
+	if err := parseId(&data); err != nil {
+		resp.Diagnostics.AddError("Unable to parse object id", err.Error())
+		return
+	}
+
+	// Read skips the tags of objects without managed tags, so read the tags of the imported object here.
+	tags, err := getObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read object tags", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags_all"), r.tags.withoutIgnored(tags))...)
 }
@@ -0,0 +1,555 @@
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccObjectResource_source(t *testing.T) {
+	content := []byte{0x1f, 0x8b, 0x08, 0x00, 0xff, 0xfe}
+	updatedContent := []byte{0x1f, 0x8b, 0x08, 0x00, 0xff, 0xfe, 0x00, 0x01}
+	sourceFile := writeTestFile(t, "source.bin", string(content))
+
+	md5sum := func(data []byte) string {
+		sum := md5.Sum(data)
+		return hex.EncodeToString(sum[:])
+	}
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(withSuffix("object-source")),
+		"source_file": config.StringVariable(sourceFile),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/object_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "source", sourceFile),
+					resource.TestCheckResourceAttr("objsto_object.this", "etag", md5sum(content)),
+					resource.TestCheckNoResourceAttr("objsto_object.this", "content"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(sourceFile, updatedContent, 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				ConfigFile:      config.StaticFile("testdata/object_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "source_hash", md5sum(updatedContent)),
+					resource.TestCheckResourceAttr("objsto_object.this", "etag", md5sum(updatedContent)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectResource_contentBase64(t *testing.T) {
+	bucketName := withSuffix("object-content-base64")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile: config.StaticFile("testdata/object_content_base64.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name": config.StringVariable(bucketName),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "content_base64", "H4sIAAAAAAAA/wMAAAAAAAAAAAA="),
+					resource.TestCheckNoResourceAttr("objsto_object.this", "content"),
+				),
+			},
+			{
+				ConfigFile: config.StaticFile("testdata/object_content_base64.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name": config.StringVariable(bucketName),
+				},
+				ResourceName:      "objsto_object.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ConfigFile: config.StaticFile("testdata/object_content_base64.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name":    config.StringVariable(bucketName),
+					"content_base64": config.StringVariable("H4sIAAAAAAAA/8tIzcnJBwCGphA2BQAAAA=="),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "content_base64", "H4sIAAAAAAAA/8tIzcnJBwCGphA2BQAAAA=="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectResource_headers(t *testing.T) {
+	bucketName := withSuffix("object-headers")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile: config.StaticFile("testdata/object_headers.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name": config.StringVariable(bucketName),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.detected", "content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr("objsto_object.this", "content_type", "text/plain"),
+					resource.TestCheckResourceAttr("objsto_object.this", "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr("objsto_object.this", "content_disposition", `attachment; filename="report.txt"`),
+					resource.TestCheckResourceAttr("objsto_object.this", "content_language", "en-US"),
+					resource.TestCheckResourceAttr("objsto_object.this", "expires", "2030-01-01T12:00:00+02:00"),
+				),
+			},
+			{
+				ConfigFile: config.StaticFile("testdata/object_headers.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name":   config.StringVariable(bucketName),
+					"cache_control": config.StringVariable("no-cache"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("objsto_object.this", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "cache_control", "no-cache"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectResource_metadata(t *testing.T) {
+	bucketName := withSuffix("object-metadata")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile: config.StaticFile("testdata/object_metadata.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name": config.StringVariable(bucketName),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("objsto_object.this", "metadata.Build-Id", "1"),
+					resource.TestCheckResourceAttr("objsto_object.this", "metadata.git-sha", "0123456789abcdef"),
+					resource.TestCheckResourceAttr("objsto_object.this", "content_type", "text/javascript; charset=utf-8"),
+				),
+			},
+			{
+				ConfigFile: config.StaticFile("testdata/object_metadata.tf"),
+				ConfigVariables: map[string]config.Variable{
+					"bucket_name": config.StringVariable(bucketName),
+					"build_id":    config.StringVariable("2"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("objsto_object.this", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "metadata.Build-Id", "2"),
+					resource.TestCheckResourceAttr("objsto_object.this", "content", "console.log('Hello objsto!');"),
+					resource.TestCheckResourceAttr("objsto_object.this", "content_type", "text/javascript; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func TestMetadataToMap(t *testing.T) {
+	ctx := t.Context()
+	current := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Build-Id": types.StringValue("1"),
+	})
+
+	actual, diags := metadataToMap(ctx, map[string]string{"build-id": "2", "git-sha": "abc"}, current)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Build-Id": types.StringValue("2"),
+		"git-sha":  types.StringValue("abc"),
+	}), actual)
+
+	actual, diags = metadataToMap(ctx, map[string]string{}, types.MapNull(types.StringType))
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, actual.IsNull())
+}
+
+func TestObjectResource_update(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("tagging") {
+			requests = append(requests, r.Method+" tagging")
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(`<Tagging><TagSet><Tag><Key>ignored</Key><Value>kept</Value></Tag></TagSet></Tagging>`))
+			return
+		}
+		requests = append(requests, fmt.Sprintf("%s copy-source=%s metadata-directive=%s tagging-directive=%s tagging=%s build-id=%s",
+			r.Method, r.Header.Get("X-Amz-Copy-Source"), r.Header.Get("X-Amz-Metadata-Directive"), r.Header.Get("X-Amz-Tagging-Directive"), r.Header.Get("X-Amz-Tagging"), r.Header.Get("X-Amz-Meta-Build-Id")))
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<CopyObjectResult><ETag>"etag"</ETag></CopyObjectResult>`))
+	})
+
+	r := &ObjectResource{client: client, tags: tagsConfig{ignoreKeys: []string{"ignored"}}}
+	s, nullTimeouts := getResourceSchema(t, r)
+
+	model := func(content, buildID string, tags map[string]string) ObjectResourceModel {
+		tagsValue := types.MapNull(types.StringType)
+		if tags != nil {
+			tagsValue, _ = types.MapValueFrom(t.Context(), types.StringType, tags)
+		}
+		tagsAll, _ := types.MapValueFrom(t.Context(), types.StringType, tags)
+		return ObjectResourceModel{
+			Bucket:      types.StringValue("bucket"),
+			Id:          types.StringValue("bucket/app.js"),
+			Key:         types.StringValue("app.js"),
+			Content:     types.StringValue(content),
+			ContentType: types.StringValue("text/javascript; charset=utf-8"),
+			Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Build-Id": types.StringValue(buildID),
+			}),
+			Tags:     tagsValue,
+			TagsAll:  tagsAll,
+			ETag:     types.StringValue("etag"),
+			URL:      types.StringValue("https://bucket.example.com/app.js"),
+			Timeouts: nullTimeouts,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		state            ObjectResourceModel
+		plan             ObjectResourceModel
+		expectedRequests []string
+	}{
+		{
+			// The metadata is replaced by copying the object onto itself instead of uploading the content again with PutObject.
+			name:  "Metadata changed",
+			state: model("content", "1", nil),
+			plan:  model("content", "2", nil),
+			expectedRequests: []string{
+				"PUT copy-source=bucket/app.js metadata-directive=REPLACE tagging-directive= tagging= build-id=2",
+			},
+		},
+		{
+			name:  "Metadata changed with tags",
+			state: model("content", "1", map[string]string{"env": "test"}),
+			plan:  model("content", "2", map[string]string{"env": "test"}),
+			expectedRequests: []string{
+				"GET tagging",
+				"PUT copy-source=bucket/app.js metadata-directive=REPLACE tagging-directive=REPLACE tagging=env=test&ignored=kept build-id=2",
+			},
+		},
+		{
+			// The tags are uploaded with the content, so that the object is not left without tags if tagging fails.
+			name:  "Content and tags changed",
+			state: model("content", "1", map[string]string{"env": "test"}),
+			plan:  model("updated content", "1", map[string]string{"env": "prod"}),
+			expectedRequests: []string{
+				"GET tagging",
+				"PUT copy-source= metadata-directive= tagging-directive= tagging=env=prod&ignored=kept build-id=1",
+			},
+		},
+		{
+			name:  "Tags changed",
+			state: model("content", "1", map[string]string{"env": "test"}),
+			plan:  model("content", "1", map[string]string{"env": "prod"}),
+			expectedRequests: []string{
+				"GET tagging",
+				"PUT tagging",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			requests = nil
+
+			state := tfsdk.State{Schema: s}
+			assert.False(t, state.Set(ctx, test.state).HasError())
+			plan := tfsdk.Plan{Schema: s}
+			assert.False(t, plan.Set(ctx, test.plan).HasError())
+
+			resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: s}}
+			r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, test.expectedRequests, requests)
+		})
+	}
+
+	t.Run("Create with tags", func(t *testing.T) {
+		ctx := t.Context()
+		requests = nil
+
+		plan := tfsdk.Plan{Schema: s}
+		assert.False(t, plan.Set(ctx, model("content", "1", map[string]string{"env": "test"})).HasError())
+
+		resp := fwresource.CreateResponse{State: tfsdk.State{Schema: s}}
+		r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{"PUT copy-source= metadata-directive= tagging-directive= tagging=env=test build-id=1"}, requests)
+	})
+}
+
+func TestObjectResource_readWithoutTaggingSupport(t *testing.T) {
+	var taggingRequests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("tagging") {
+			taggingRequests++
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotImplemented)
+			_, _ = w.Write([]byte(`<Error><Code>NotImplemented</Code><Message>Tagging is not supported.</Message></Error>`))
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("content"))
+	})
+
+	r := &ObjectResource{client: client}
+	s, nullTimeouts := getResourceSchema(t, r)
+
+	tests := []struct {
+		name                    string
+		tags                    types.Map
+		tagsAll                 types.Map
+		expectedTaggingRequests int
+	}{
+		{
+			name:    "Tags not configured",
+			tags:    types.MapNull(types.StringType),
+			tagsAll: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		{
+			name:    "Upgraded from version without tags",
+			tags:    types.MapNull(types.StringType),
+			tagsAll: types.MapNull(types.StringType),
+		},
+		{
+			name: "Tags configured",
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("test"),
+			}),
+			tagsAll: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("test"),
+			}),
+			expectedTaggingRequests: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			taggingRequests = 0
+
+			state := tfsdk.State{Schema: s}
+			assert.False(t, state.Set(ctx, ObjectResourceModel{
+				Bucket:      types.StringValue("bucket"),
+				Id:          types.StringValue("bucket/key.txt"),
+				Key:         types.StringValue("key.txt"),
+				Content:     types.StringValue("content"),
+				ContentType: types.StringValue("text/plain"),
+				Metadata:    types.MapNull(types.StringType),
+				Tags:        test.tags,
+				TagsAll:     test.tagsAll,
+				Timeouts:    nullTimeouts,
+			}).HasError())
+
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, test.expectedTaggingRequests, taggingRequests)
+		})
+	}
+
+	t.Run("Import", func(t *testing.T) {
+		ctx := t.Context()
+		taggingRequests = 0
+
+		resp := fwresource.ImportStateResponse{
+			State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: "bucket/key.txt"}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, 1, taggingRequests)
+	})
+}
+
+func TestCopySource(t *testing.T) {
+	assert.Equal(t, "bucket/dir/key.txt", copySource("bucket", "dir/key.txt"))
+	assert.Equal(t, "bucket/dir/with%20space+plus.txt", copySource("bucket", "dir/with space+plus.txt"))
+}
+
+func TestAccObjectResource_tags(t *testing.T) {
+	bucketName := withSuffix("object-tags")
+	variables := func(defaultTags, tags map[string]string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"bucket_name":  config.StringVariable(bucketName),
+			"default_tags": stringMapVariable(defaultTags),
+			"tags":         stringMapVariable(tags),
+		}
+	}
+
+	// Provider factories are defined on step level, because the configuration file contains a provider block.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/object_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "test"}, map[string]string{"app": "web"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "tags.%", "1"),
+					resource.TestCheckResourceAttr("objsto_object.this", "tags.app", "web"),
+					resource.TestCheckResourceAttr("objsto_object.this", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("objsto_object.this", "tags_all.env", "test"),
+					checkObjectTags(bucketName, "tagged.txt", map[string]string{"env": "test", "app": "web"}),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/object_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "test"}, map[string]string{"app": "web"}),
+				ResourceName:             "objsto_object.this",
+				ImportState:              true,
+				ImportStateVerify:        true,
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/object_tags.tf"),
+				ConfigVariables:          variables(map[string]string{"env": "prod"}, map[string]string{"app": "api"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object.this", "tags_all.env", "prod"),
+					resource.TestCheckResourceAttr("objsto_object.this", "tags_all.app", "api"),
+					checkObjectTags(bucketName, "tagged.txt", map[string]string{"env": "prod", "app": "api"}),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ConfigFile:               config.StaticFile("testdata/object_tags.tf"),
+				ConfigVariables: variables(
+					map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6"},
+					map[string]string{"g": "7", "h": "8", "i": "9", "j": "10", "k": "11"},
+				),
+				ExpectError: regexp.MustCompile("Too many tags"),
+			},
+		},
+	})
+}
+
+func TestSetObjectHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  types.String
+		header   *string
+		expected types.String
+	}{
+		{
+			name:     "Same time in different time zone",
+			current:  types.StringValue("2030-01-01T12:00:00+02:00"),
+			header:   aws.String("Tue, 01 Jan 2030 10:00:00 GMT"),
+			expected: types.StringValue("2030-01-01T12:00:00+02:00"),
+		},
+		{
+			name:     "Changed time",
+			current:  types.StringValue("2030-01-01T12:00:00+02:00"),
+			header:   aws.String("Tue, 01 Jan 2030 12:00:00 GMT"),
+			expected: types.StringValue("2030-01-01T12:00:00Z"),
+		},
+		{
+			name:     "Removed header",
+			current:  types.StringValue("2030-01-01T12:00:00+02:00"),
+			header:   nil,
+			expected: types.StringNull(),
+		},
+		{
+			name:     "Imported header",
+			current:  types.StringNull(),
+			header:   aws.String("Tue, 01 Jan 2030 10:00:00 GMT"),
+			expected: types.StringValue("2030-01-01T10:00:00Z"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := ObjectResourceModel{Expires: test.current}
+			setObjectHeaders(&data, objectHeaders{Expires: test.header})
+			assert.Equal(t, test.expected, data.Expires)
+		})
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	assert.Equal(t, "application/json", detectContentType("dir/hello.json"))
+	assert.Equal(t, "image/png", detectContentType("image.PNG"))
+	assert.Equal(t, "application/octet-stream", detectContentType("dir.d/no-extension"))
+}
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpoint     string
+		usePathStyle bool
+		expected     string
+	}{
+		{
+			name:         "Path-style",
+			endpoint:     "https://objsto.example.com",
+			usePathStyle: true,
+			expected:     "https://objsto.example.com/bucket/dir/key.txt",
+		},
+		{
+			name:         "Path-style with trailing slash",
+			endpoint:     "https://objsto.example.com/",
+			usePathStyle: true,
+			expected:     "https://objsto.example.com/bucket/dir/key.txt",
+		},
+		{
+			name:         "Virtual-hosted-style",
+			endpoint:     "https://objsto.example.com",
+			usePathStyle: false,
+			expected:     "https://bucket.objsto.example.com/dir/key.txt",
+		},
+		{
+			name:         "Virtual-hosted-style with port and trailing slash",
+			endpoint:     "http://localhost:9000/",
+			usePathStyle: false,
+			expected:     "http://bucket.localhost:9000/dir/key.txt",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := s3.Options{
+				BaseEndpoint: aws.String(test.endpoint),
+				UsePathStyle: test.usePathStyle,
+			}
+			assert.Equal(t, test.expected, buildURL(options, "bucket", "dir/key.txt"))
+		})
+	}
+}
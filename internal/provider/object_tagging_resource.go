@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ObjectTaggingResource{}
+var _ resource.ResourceWithImportState = &ObjectTaggingResource{}
+
+func NewObjectTaggingResource() resource.Resource {
+	return &ObjectTaggingResource{}
+}
+
+// ObjectTaggingResource defines the resource implementation.
+type ObjectTaggingResource struct {
+	client *s3.Client
+	tags   tagsConfig
+}
+
+// ObjectTaggingResourceModel describes the resource data model.
+type ObjectTaggingResourceModel struct {
+	Bucket    types.String   `tfsdk:"bucket"`
+	Key       types.String   `tfsdk:"key"`
+	VersionID types.String   `tfsdk:"version_id"`
+	Tags      types.Map      `tfsdk:"tags"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ObjectTaggingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_tagging"
+}
+
+func (r *ObjectTaggingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An object tagging resource that manages the tags of an object that is not managed with the `objsto_object` resource. Provider `default_tags` are not applied to the tags, but tags matching the provider `ignore_tags` configuration are kept on the object.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket where the object is stored.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the object.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The version ID of the object. If not set, the tags are assigned to the latest version of the object. To import the tags of a specific version, use `{bucket}/{key}?versionId={version_id}` as the import ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				Required:            true,
+				MarkdownDescription: "The tags to assign to the object. An object can have at most 10 tags.",
+				ElementType:         types.StringType,
+				Validators:          objectTagsValidators(),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ObjectTaggingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var data *objStoProviderData
+	data, resp.Diagnostics = getProviderData(req.ProviderData)
+	if data != nil {
+		r.client = data.client
+		r.tags = data.tags
+	}
+}
+
+// put replaces the tags of the object with the given tags. Tags are replaced as a whole, so ignored tags are read from the object to avoid removing them.
+func (r *ObjectTaggingResource) put(ctx context.Context, data *ObjectTaggingResourceModel, tags map[string]string) error {
+	currentTags, err := getObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), data.VersionID.ValueStringPointer())
+	if err != nil {
+		return err
+	}
+	return putObjectTags(ctx, r.client, data.Bucket.ValueString(), data.Key.ValueString(), data.VersionID.ValueStringPointer(), r.tags.withIgnoredFrom(tags, currentTags))
+}
+
+// isNotFound checks whether the error is caused by the object or the bucket not existing.
+func isNotFound(err error) bool {
+	var re *awshttp.ResponseError
+	return errors.As(err, &re) && re.HTTPStatusCode() == 404
+}
+
+func (r *ObjectTaggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ObjectTaggingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Create, defaultTimeout, "create", &resp.Diagnostics)
+	defer done()
+
+	tags, diags := tagsFromMap(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.put(ctx, &data, tags); err != nil {
+		resp.Diagnostics.AddError("Unable to put object tags", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectTaggingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ObjectTaggingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Read, defaultTimeout, "read", &resp.Diagnostics)
+	defer done()
+
+	output, err := r.client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+		Bucket:    data.Bucket.ValueStringPointer(),
+		Key:       data.Key.ValueStringPointer(),
+		VersionId: data.VersionID.ValueStringPointer(),
+	})
+	if err != nil && !isNoSuchTagSet(err) {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read object tags", err.Error())
+		return
+	}
+
+	tags := map[string]string{}
+	if output != nil {
+		tags = r.tags.withoutIgnored(tagsFromS3(output.TagSet))
+	}
+
+	var diags diag.Diagnostics
+	data.Tags, diags = types.MapValueFrom(ctx, types.StringType, tags)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectTaggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ObjectTaggingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Update, defaultTimeout, "update", &resp.Diagnostics)
+	defer done()
+
+	tags, diags := tagsFromMap(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.put(ctx, &data, tags); err != nil {
+		resp.Diagnostics.AddError("Unable to put object tags", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectTaggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ObjectTaggingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, data.Timeouts.Delete, defaultTimeout, "delete", &resp.Diagnostics)
+	defer done()
+
+	// Tags of a deleted object do not need to be removed.
+	if err := r.put(ctx, &data, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Unable to delete object tags", err.Error())
+	}
+}
+
+// importVersionIDSeparator separates the optional version ID from the object in the import id.
+const importVersionIDSeparator = "?versionId="
+
+// ImportState imports the tags of the object with `{bucket}/{key}` id, or the tags of an object version with `{bucket}/{key}?versionId={version_id}` id.
+func (r *ObjectTaggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, versionID := req.ID, ""
+	if i := strings.LastIndex(id, importVersionIDSeparator); i >= 0 {
+		id, versionID = id[:i], id[i+len(importVersionIDSeparator):]
+	}
+
+	bucket, key, ok := strings.Cut(id, "/")
+	if !ok || bucket == "" || key == "" || (id != req.ID && versionID == "") {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected import id in {bucket}/{key} or {bucket}/{key}?versionId={version_id} format, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucket)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+	if versionID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version_id"), versionID)...)
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func checkObjectTags(bucket, key string, expected map[string]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.TODO()
+		client := testAccClient(ctx)
+		output, err := client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{Bucket: &bucket, Key: &key})
+		if err != nil {
+			return fmt.Errorf("failed to get object tags: %w", err)
+		}
+
+		if tags := tagsFromS3(output.TagSet); !maps.Equal(tags, expected) {
+			return fmt.Errorf("expected object tags %v, got %v", expected, tags)
+		}
+		return nil
+	}
+}
+
+func TestAccObjectTaggingResource(t *testing.T) {
+	bucketName := withSuffix("object-tagging")
+	variables := func(tags map[string]string) map[string]config.Variable {
+		variables := map[string]config.Variable{
+			"bucket_name": config.StringVariable(bucketName),
+		}
+		if tags != nil {
+			variables["tags"] = stringMapVariable(tags)
+		}
+		return variables
+	}
+
+	tooManyTags := map[string]string{}
+	for i := range maxObjectTags + 1 {
+		tooManyTags[fmt.Sprintf("tag-%d", i)] = "value"
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(nil),
+			},
+			{
+				PreConfig:       func() { putObjects(bucketName, "unmanaged.txt") },
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(map[string]string{"env": "test", "team": "objsto"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object_tagging.this.0", "tags.%", "2"),
+					checkObjectTags(bucketName, "unmanaged.txt", map[string]string{"env": "test", "team": "objsto"}),
+				),
+			},
+			{
+				ConfigFile:                           config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables:                      variables(map[string]string{"env": "test", "team": "objsto"}),
+				ResourceName:                         "objsto_object_tagging.this.0",
+				ImportState:                          true,
+				ImportStateId:                        bucketName + "/unmanaged.txt",
+				ImportStateVerifyIdentifierAttribute: "key",
+				ImportStateVerify:                    true,
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(map[string]string{"env": "prod"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("objsto_object_tagging.this.0", "tags.%", "1"),
+					checkObjectTags(bucketName, "unmanaged.txt", map[string]string{"env": "prod"}),
+				),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(tooManyTags),
+				ExpectError:     regexp.MustCompile("map must contain at most 10 elements"),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(map[string]string{strings.Repeat("k", maxTagKeyLength+1): "value"}),
+				ExpectError:     regexp.MustCompile("UTF-8 character count must be between 1 and 128"),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_tagging.tf"),
+				ConfigVariables: variables(nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkObjectTags(bucketName, "unmanaged.txt", map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestObjectTaggingResource_deleteMissingObject(t *testing.T) {
+	ctx := t.Context()
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+	})
+
+	r := &ObjectTaggingResource{client: client}
+	s, nullTimeouts := getResourceSchema(t, r)
+
+	state := tfsdk.State{Schema: s}
+	assert.False(t, state.Set(ctx, ObjectTaggingResourceModel{
+		Bucket: types.StringValue("bucket"),
+		Key:    types.StringValue("deleted.txt"),
+		Tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"env": types.StringValue("test"),
+		}),
+		Timeouts: nullTimeouts,
+	}).HasError())
+
+	var resp fwresource.DeleteResponse
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"GET /bucket/deleted.txt"}, requests)
+}
+
+func TestObjectTaggingResource_importState(t *testing.T) {
+	ctx := t.Context()
+
+	r := &ObjectTaggingResource{}
+	s, _ := getResourceSchema(t, r)
+
+	tests := []struct {
+		id                string
+		expectedBucket    string
+		expectedKey       string
+		expectedVersionID types.String
+		expectedError     bool
+	}{
+		{
+			id:                "bucket/dir/key.txt",
+			expectedBucket:    "bucket",
+			expectedKey:       "dir/key.txt",
+			expectedVersionID: types.StringNull(),
+		},
+		{
+			id:                "bucket/dir/key.txt?versionId=3HL4kqtJlcpXroDTDmjVBH40Nrjfkd",
+			expectedBucket:    "bucket",
+			expectedKey:       "dir/key.txt",
+			expectedVersionID: types.StringValue("3HL4kqtJlcpXroDTDmjVBH40Nrjfkd"),
+		},
+		{
+			id:            "bucket/key.txt?versionId=",
+			expectedError: true,
+		},
+		{
+			id:            "bucket",
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: s,
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: test.id}, &resp)
+			assert.Equal(t, test.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if test.expectedError {
+				return
+			}
+
+			var data ObjectTaggingResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, test.expectedBucket, data.Bucket.ValueString())
+			assert.Equal(t, test.expectedKey, data.Key.ValueString())
+			assert.Equal(t, test.expectedVersionID, data.VersionID)
+		})
+	}
+}
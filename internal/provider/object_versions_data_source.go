@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectVersionsDataSource{}
+
+func NewObjectVersionsDataSource() datasource.DataSource {
+	return &ObjectVersionsDataSource{}
+}
+
+// ObjectVersionsDataSource defines the data source implementation.
+type ObjectVersionsDataSource struct {
+	client *s3.Client
+}
+
+// ObjectVersionsDataSourceModel describes the data source data model.
+type ObjectVersionsDataSourceModel struct {
+	Bucket        types.String `tfsdk:"bucket"`
+	Key           types.String `tfsdk:"key"`
+	Prefix        types.String `tfsdk:"prefix"`
+	Versions      types.List   `tfsdk:"versions"`
+	DeleteMarkers types.List   `tfsdk:"delete_markers"`
+}
+
+type ObjectVersionsDataSourceVersion struct {
+	Key          types.String `tfsdk:"key"`
+	VersionID    types.String `tfsdk:"version_id"`
+	IsLatest     types.Bool   `tfsdk:"is_latest"`
+	LastModified types.String `tfsdk:"last_modified"`
+	Size         types.Int64  `tfsdk:"size"`
+	ETag         types.String `tfsdk:"etag"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+func (m ObjectVersionsDataSourceVersion) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":           types.StringType,
+		"version_id":    types.StringType,
+		"is_latest":     types.BoolType,
+		"last_modified": types.StringType,
+		"size":          types.Int64Type,
+		"etag":          types.StringType,
+		"storage_class": types.StringType,
+	}
+}
+
+type ObjectVersionsDataSourceDeleteMarker struct {
+	Key          types.String `tfsdk:"key"`
+	VersionID    types.String `tfsdk:"version_id"`
+	IsLatest     types.Bool   `tfsdk:"is_latest"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+func (m ObjectVersionsDataSourceDeleteMarker) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":           types.StringType,
+		"version_id":    types.StringType,
+		"is_latest":     types.BoolType,
+		"last_modified": types.StringType,
+	}
+}
+
+func (d *ObjectVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_versions"
+}
+
+func (d *ObjectVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An object versions data source that lists the versions and delete markers of objects stored in a versioned bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include versions of the object with this key. Conflicts with `prefix`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("prefix")),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include versions of objects with keys that start with this prefix. Conflicts with `key`.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The object versions, ordered by key and from the newest to the oldest version.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The key of the object.",
+						},
+						"version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version ID of the object.",
+						},
+						"is_latest": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the latest version of the object.",
+						},
+						"last_modified": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The last modification time of the version in RFC3339 format.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the version in bytes.",
+						},
+						"etag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The entity tag of the version.",
+						},
+						"storage_class": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The storage class of the version.",
+						},
+					},
+				},
+			},
+			"delete_markers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The delete markers, ordered by key and from the newest to the oldest delete marker.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The key of the object.",
+						},
+						"version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version ID of the delete marker.",
+						},
+						"is_latest": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this delete marker is the latest version of the object, i.e., whether the object is currently deleted.",
+						},
+						"last_modified": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The creation time of the delete marker in RFC3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ObjectVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = getClientFromProviderData(req.ProviderData)
+}
+
+func (d *ObjectVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ListObjectVersions does not support filtering by exact key, so list by prefix and filter the keys here.
+	prefix := data.Prefix.ValueStringPointer()
+	if !data.Key.IsNull() {
+		prefix = data.Key.ValueStringPointer()
+	}
+	matches := func(key *string) bool {
+		return data.Key.IsNull() || *key == data.Key.ValueString()
+	}
+
+	versions := []ObjectVersionsDataSourceVersion{}
+	deleteMarkers := []ObjectVersionsDataSourceDeleteMarker{}
+	paginator := s3.NewListObjectVersionsPaginator(d.client, &s3.ListObjectVersionsInput{
+		Bucket: data.Bucket.ValueStringPointer(),
+		Prefix: prefix,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list object versions", err.Error())
+			return
+		}
+
+		for _, version := range page.Versions {
+			if !matches(version.Key) {
+				continue
+			}
+			versions = append(versions, ObjectVersionsDataSourceVersion{
+				Key:          types.StringPointerValue(version.Key),
+				VersionID:    types.StringPointerValue(version.VersionId),
+				IsLatest:     types.BoolPointerValue(version.IsLatest),
+				LastModified: formatTime(version.LastModified),
+				Size:         types.Int64PointerValue(version.Size),
+				ETag:         trimETag(version.ETag),
+				StorageClass: types.StringValue(string(version.StorageClass)),
+			})
+		}
+
+		for _, marker := range page.DeleteMarkers {
+			if !matches(marker.Key) {
+				continue
+			}
+			deleteMarkers = append(deleteMarkers, ObjectVersionsDataSourceDeleteMarker{
+				Key:          types.StringPointerValue(marker.Key),
+				VersionID:    types.StringPointerValue(marker.VersionId),
+				IsLatest:     types.BoolPointerValue(marker.IsLatest),
+				LastModified: formatTime(marker.LastModified),
+			})
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Versions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ObjectVersionsDataSourceVersion{}.AttributeTypes()}, versions)
+	resp.Diagnostics.Append(diags...)
+
+	data.DeleteMarkers, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ObjectVersionsDataSourceDeleteMarker{}.AttributeTypes()}, deleteMarkers)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
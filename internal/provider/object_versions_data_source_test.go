@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectVersionsDataSource(t *testing.T) {
+	bucket_name := withSuffix("object-versions-data-source")
+	variables := func(content string) map[string]config.Variable {
+		return map[string]config.Variable{
+			"bucket_name":    config.StringVariable(bucket_name),
+			"object_content": config.StringVariable(content),
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/object_versions_data_source.tf"),
+				ConfigVariables: variables("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.0.key", "object.txt"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.0.is_latest", "true"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.0.size", "2"),
+					resource.TestCheckResourceAttrSet("data.objsto_object_versions.this", "versions.0.version_id"),
+					resource.TestCheckResourceAttrSet("data.objsto_object_versions.this", "versions.0.etag"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "delete_markers.#", "0"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.prefix", "versions.#", "2"),
+				),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_versions_data_source.tf"),
+				ConfigVariables: variables("v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.0.is_latest", "true"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.1.is_latest", "false"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.prefix", "versions.#", "3"),
+				),
+			},
+			{
+				ConfigFile:      config.StaticFile("testdata/object_versions_data_source.tf"),
+				ConfigVariables: variables(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "versions.0.is_latest", "false"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "delete_markers.#", "1"),
+					resource.TestCheckResourceAttr("data.objsto_object_versions.this", "delete_markers.0.is_latest", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ObjectsDataSource{}
+
+func NewObjectsDataSource() datasource.DataSource {
+	return &ObjectsDataSource{}
+}
+
+// ObjectsDataSource defines the data source implementation.
+type ObjectsDataSource struct {
+	client *s3.Client
+}
+
+// ObjectsDataSourceModel describes the data source data model.
+type ObjectsDataSourceModel struct {
+	Bucket         types.String `tfsdk:"bucket"`
+	Prefix         types.String `tfsdk:"prefix"`
+	Delimiter      types.String `tfsdk:"delimiter"`
+	StartAfter     types.String `tfsdk:"start_after"`
+	MaxKeys        types.Int32  `tfsdk:"max_keys"`
+	Keys           types.List   `tfsdk:"keys"`
+	CommonPrefixes types.List   `tfsdk:"common_prefixes"`
+	Objects        types.List   `tfsdk:"objects"`
+}
+
+type ObjectsDataSourceObject struct {
+	Key          types.String `tfsdk:"key"`
+	Size         types.Int64  `tfsdk:"size"`
+	ETag         types.String `tfsdk:"etag"`
+	StorageClass types.String `tfsdk:"storage_class"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+func (m ObjectsDataSourceObject) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":           types.StringType,
+		"size":          types.Int64Type,
+		"etag":          types.StringType,
+		"storage_class": types.StringType,
+		"last_modified": types.StringType,
+	}
+}
+
+func (d *ObjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects"
+}
+
+func (d *ObjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An objects data source that lists the objects stored in a bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include objects with keys that start with this prefix.",
+			},
+			"delimiter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A character used to group keys. Keys that contain the delimiter after the prefix are grouped into `common_prefixes` instead of being listed in `keys`.",
+			},
+			"start_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include objects with keys that are after this key in lexicographical order.",
+			},
+			"max_keys": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of keys and common prefixes to return. By default, all matching keys are returned.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"keys": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The keys of the objects.",
+				ElementType:         types.StringType,
+			},
+			"common_prefixes": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The common prefixes of the keys, when `delimiter` is set.",
+				ElementType:         types.StringType,
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The objects.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The key of the object.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the object in bytes.",
+						},
+						"etag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The entity tag of the object.",
+						},
+						"storage_class": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The storage class of the object.",
+						},
+						"last_modified": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The last modification time of the object in RFC3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = getClientFromProviderData(req.ProviderData)
+}
+
+// listObjectsPageSize is the maximum number of keys returned by a single ListObjectsV2 request.
+const listObjectsPageSize = 1000
+
+func (d *ObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxKeys := int(data.MaxKeys.ValueInt32())
+	pageSize := listObjectsPageSize
+	if maxKeys > 0 {
+		pageSize = min(maxKeys, listObjectsPageSize)
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(d.client, &s3.ListObjectsV2Input{
+		Bucket:     data.Bucket.ValueStringPointer(),
+		Prefix:     data.Prefix.ValueStringPointer(),
+		Delimiter:  data.Delimiter.ValueStringPointer(),
+		StartAfter: data.StartAfter.ValueStringPointer(),
+	}, func(o *s3.ListObjectsV2PaginatorOptions) {
+		o.Limit = int32(pageSize)
+	})
+
+	keys := []string{}
+	commonPrefixes := []string{}
+	objects := []ObjectsDataSourceObject{}
+	isFull := func() bool {
+		return maxKeys > 0 && len(keys)+len(commonPrefixes) >= maxKeys
+	}
+
+	for paginator.HasMorePages() && !isFull() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list objects", err.Error())
+			return
+		}
+
+		// S3 counts the objects and common prefixes together towards the limit in lexicographic order, so merge them before applying the limit.
+		contents, prefixes := page.Contents, page.CommonPrefixes
+		for (len(contents) > 0 || len(prefixes) > 0) && !isFull() {
+			if len(prefixes) == 0 || (len(contents) > 0 && aws.ToString(contents[0].Key) < aws.ToString(prefixes[0].Prefix)) {
+				object := contents[0]
+				contents = contents[1:]
+
+				keys = append(keys, aws.ToString(object.Key))
+				objects = append(objects, ObjectsDataSourceObject{
+					Key:          types.StringPointerValue(object.Key),
+					Size:         types.Int64PointerValue(object.Size),
+					ETag:         trimETag(object.ETag),
+					StorageClass: types.StringValue(string(object.StorageClass)),
+					LastModified: formatTime(object.LastModified),
+				})
+			} else {
+				commonPrefixes = append(commonPrefixes, aws.ToString(prefixes[0].Prefix))
+				prefixes = prefixes[1:]
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+
+	data.CommonPrefixes, diags = types.ListValueFrom(ctx, types.StringType, commonPrefixes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Objects, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ObjectsDataSourceObject{}.AttributeTypes()}, objects)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
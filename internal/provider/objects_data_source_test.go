@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccObjectsDataSource(t *testing.T) {
+	bucket_name := withSuffix("objects-data-source")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/objects_data_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_objects.all", "keys.#", "5"),
+					resource.TestCheckResourceAttr("data.objsto_objects.all", "common_prefixes.#", "0"),
+					resource.TestCheckResourceAttr("data.objsto_objects.all", "objects.0.key", "other.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.all", "objects.0.size", "9"),
+					resource.TestCheckResourceAttrSet("data.objsto_objects.all", "objects.0.etag"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "keys.0", "releases/latest.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "keys.1", "releases/v3.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "common_prefixes.0", "releases/v1/"),
+					resource.TestCheckResourceAttr("data.objsto_objects.releases", "common_prefixes.1", "releases/v2/"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited", "keys.0", "releases/latest.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited", "keys.1", "releases/v1/app.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited_releases", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited_releases", "keys.0", "releases/latest.txt"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited_releases", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited_releases", "common_prefixes.0", "releases/v1/"),
+					resource.TestCheckResourceAttr("data.objsto_objects.limited_releases", "common_prefixes.1", "releases/v2/"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PolicyDocumentDataSource{}
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &PolicyDocumentDataSource{}
+}
+
+// PolicyDocumentDataSource defines the data source implementation.
+type PolicyDocumentDataSource struct{}
+
+// PolicyDocumentDataSourceModel describes the data source data model.
+type PolicyDocumentDataSourceModel struct {
+	Version                 types.String `tfsdk:"version"`
+	PolicyID                types.String `tfsdk:"policy_id"`
+	SourcePolicyDocuments   types.List   `tfsdk:"source_policy_documents"`
+	OverridePolicyDocuments types.List   `tfsdk:"override_policy_documents"`
+	Statements              types.List   `tfsdk:"statement"`
+	JSON                    types.String `tfsdk:"json"`
+}
+
+type PolicyDocumentStatement struct {
+	Sid           types.String `tfsdk:"sid"`
+	Effect        types.String `tfsdk:"effect"`
+	Actions       types.Set    `tfsdk:"actions"`
+	NotActions    types.Set    `tfsdk:"not_actions"`
+	Resources     types.Set    `tfsdk:"resources"`
+	NotResources  types.Set    `tfsdk:"not_resources"`
+	Principals    types.List   `tfsdk:"principals"`
+	NotPrincipals types.List   `tfsdk:"not_principals"`
+	Conditions    types.List   `tfsdk:"condition"`
+}
+
+type PolicyDocumentPrincipal struct {
+	Type        types.String `tfsdk:"type"`
+	Identifiers types.Set    `tfsdk:"identifiers"`
+}
+
+type PolicyDocumentCondition struct {
+	Test     types.String `tfsdk:"test"`
+	Variable types.String `tfsdk:"variable"`
+	Values   types.Set    `tfsdk:"values"`
+}
+
+const defaultPolicyVersion = "2012-10-17"
+
+func (d *PolicyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_document"
+}
+
+func policyPrincipalsBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The type of the principals, e.g., `AWS`.",
+				},
+				"identifiers": schema.SetAttribute{
+					Required:            true,
+					MarkdownDescription: "The identifiers of the principals. Use `*` to match all principals.",
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A policy document data source that generates a bucket policy document in JSON format. The generated document is in the same normalized form that the `objsto_bucket_policy` resource uses when comparing policy documents.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The version of the policy language. Defaults to `%s`.", defaultPolicyVersion),
+			},
+			"policy_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the policy document.",
+			},
+			"source_policy_documents": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: "Policy documents to use as a base for the generated document. Statements with non-empty `sid` in later documents and in the `statement` blocks replace statements with the same `sid`. Other statements are appended to the document.",
+				ElementType:         types.StringType,
+			},
+			"override_policy_documents": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: "Policy documents to merge into the generated document. Statements with non-empty `sid` replace statements with the same `sid` in the source documents and `statement` blocks. Other statements are appended to the document.",
+				ElementType:         types.StringType,
+			},
+			"json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The generated policy document in JSON format.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				MarkdownDescription: "A statement to include in the policy document.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The identifier of the statement. Must be unique within the `statement` blocks.",
+						},
+						"effect": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether the statement allows or denies the access. Valid values are `Allow` and `Deny`. Defaults to `Allow`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("Allow", "Deny"),
+							},
+						},
+						"actions": schema.SetAttribute{
+							Optional:            true,
+							MarkdownDescription: "The actions that the statement applies to, e.g., `s3:GetObject`.",
+							ElementType:         types.StringType,
+						},
+						"not_actions": schema.SetAttribute{
+							Optional:            true,
+							MarkdownDescription: "The actions that the statement does not apply to.",
+							ElementType:         types.StringType,
+						},
+						"resources": schema.SetAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ARNs of the resources that the statement applies to.",
+							ElementType:         types.StringType,
+						},
+						"not_resources": schema.SetAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ARNs of the resources that the statement does not apply to.",
+							ElementType:         types.StringType,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"principals":     policyPrincipalsBlock("The principals that the statement applies to."),
+						"not_principals": policyPrincipalsBlock("The principals that the statement does not apply to."),
+						"condition": schema.ListNestedBlock{
+							MarkdownDescription: "A condition that must be met for the statement to apply.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The condition operator, e.g., `StringLike`.",
+									},
+									"variable": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The condition key to evaluate, e.g., `aws:SourceIp`.",
+									},
+									"values": schema.SetAttribute{
+										Required:            true,
+										MarkdownDescription: "The values to compare the condition key against.",
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policyStringOrSlice is a policy document value that can be either a string or an array of strings. Booleans and numbers, e.g., in condition values, are converted to strings.
+type policyStringOrSlice []string
+
+func (s *policyStringOrSlice) UnmarshalJSON(b []byte) error {
+	var list []json.RawMessage
+	if err := json.Unmarshal(b, &list); err != nil {
+		list = []json.RawMessage{b}
+	}
+
+	values := make([]string, len(list))
+	for i, raw := range list {
+		value, err := parsePolicyString(raw)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+	}
+	*s = values
+	return nil
+}
+
+// parsePolicyString parses a string, boolean, or number value of a policy document into a string.
+func parsePolicyString(b []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	}
+	return "", fmt.Errorf("expected a string, boolean, or number, got %s", b)
+}
+
+// policyPrincipals is a policy document principal. The `"*"` wildcard is parsed into `{"AWS": ["*"]}`.
+type policyPrincipals map[string]policyStringOrSlice
+
+func (p *policyPrincipals) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err == nil {
+		*p = policyPrincipals{"AWS": {str}}
+		return nil
+	}
+
+	var principals map[string]policyStringOrSlice
+	if err := json.Unmarshal(b, &principals); err != nil {
+		return err
+	}
+	*p = principals
+	return nil
+}
+
+type policyStatement struct {
+	Sid          string                                    `json:"Sid,omitempty"`
+	Effect       string                                    `json:"Effect,omitempty"`
+	Action       policyStringOrSlice                       `json:"Action,omitempty"`
+	NotAction    policyStringOrSlice                       `json:"NotAction,omitempty"`
+	Resource     policyStringOrSlice                       `json:"Resource,omitempty"`
+	NotResource  policyStringOrSlice                       `json:"NotResource,omitempty"`
+	Principal    policyPrincipals                          `json:"Principal,omitempty"`
+	NotPrincipal policyPrincipals                          `json:"NotPrincipal,omitempty"`
+	Condition    map[string]map[string]policyStringOrSlice `json:"Condition,omitempty"`
+}
+
+type policyDocument struct {
+	Version   string             `json:"Version,omitempty"`
+	ID        string             `json:"Id,omitempty"`
+	Statement []*policyStatement `json:"Statement"`
+}
+
+func parsePolicyDocument(document string) (*policyDocument, error) {
+	var unmarshaled policyDocument
+	if err := json.Unmarshal([]byte(document), &unmarshaled); err != nil {
+		return nil, err
+	}
+	return &unmarshaled, nil
+}
+
+// mergePolicyDocuments merges override into base. Statements with non-empty Sid replace the statements with the same Sid in base, other statements are appended.
+func mergePolicyDocuments(base, override *policyDocument) *policyDocument {
+	merged := &policyDocument{
+		Version:   base.Version,
+		ID:        base.ID,
+		Statement: slices.Clone(base.Statement),
+	}
+	if override.Version != "" {
+		merged.Version = override.Version
+	}
+	if override.ID != "" {
+		merged.ID = override.ID
+	}
+
+	for _, statement := range override.Statement {
+		i := -1
+		if statement.Sid != "" {
+			i = slices.IndexFunc(merged.Statement, func(s *policyStatement) bool {
+				return s.Sid == statement.Sid
+			})
+		}
+
+		if i >= 0 {
+			merged.Statement[i] = statement
+		} else {
+			merged.Statement = append(merged.Statement, statement)
+		}
+	}
+	return merged
+}
+
+func setToSortedStrings(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() {
+		return nil, nil
+	}
+
+	var values []string
+	diags := set.ElementsAs(ctx, &values, false)
+	slices.Sort(values)
+	return values, diags
+}
+
+func policyPrincipalsFromList(ctx context.Context, list types.List) (policyPrincipals, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	var principalsData []PolicyDocumentPrincipal
+	diags.Append(list.ElementsAs(ctx, &principalsData, false)...)
+
+	if len(principalsData) == 0 {
+		return nil, diags
+	}
+
+	principals := policyPrincipals{}
+	for _, principal := range principalsData {
+		var identifiers []string
+		identifiers, d = setToSortedStrings(ctx, principal.Identifiers)
+		diags.Append(d...)
+
+		principalType := principal.Type.ValueString()
+		principals[principalType] = append(principals[principalType], identifiers...)
+	}
+	return principals, diags
+}
+
+func (s PolicyDocumentStatement) toPolicyStatement(ctx context.Context) (*policyStatement, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	statement := &policyStatement{
+		Sid:    s.Sid.ValueString(),
+		Effect: s.Effect.ValueString(),
+	}
+	if statement.Effect == "" {
+		statement.Effect = "Allow"
+	}
+
+	var values []string
+	values, d = setToSortedStrings(ctx, s.Actions)
+	diags.Append(d...)
+	statement.Action = values
+
+	values, d = setToSortedStrings(ctx, s.NotActions)
+	diags.Append(d...)
+	statement.NotAction = values
+
+	values, d = setToSortedStrings(ctx, s.Resources)
+	diags.Append(d...)
+	statement.Resource = values
+
+	values, d = setToSortedStrings(ctx, s.NotResources)
+	diags.Append(d...)
+	statement.NotResource = values
+
+	statement.Principal, d = policyPrincipalsFromList(ctx, s.Principals)
+	diags.Append(d...)
+
+	statement.NotPrincipal, d = policyPrincipalsFromList(ctx, s.NotPrincipals)
+	diags.Append(d...)
+
+	var conditions []PolicyDocumentCondition
+	diags.Append(s.Conditions.ElementsAs(ctx, &conditions, false)...)
+
+	for _, condition := range conditions {
+		if statement.Condition == nil {
+			statement.Condition = map[string]map[string]policyStringOrSlice{}
+		}
+
+		test := condition.Test.ValueString()
+		if statement.Condition[test] == nil {
+			statement.Condition[test] = map[string]policyStringOrSlice{}
+		}
+
+		values, d = setToSortedStrings(ctx, condition.Values)
+		diags.Append(d...)
+
+		variable := condition.Variable.ValueString()
+		statement.Condition[test][variable] = append(statement.Condition[test][variable], values...)
+	}
+
+	return statement, diags
+}
+
+func (d *PolicyDocumentDataSource) mergeDocuments(ctx context.Context, document *policyDocument, attribute string, list types.List) (*policyDocument, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var documents []string
+	diags.Append(list.ElementsAs(ctx, &documents, false)...)
+
+	for i, s := range documents {
+		parsed, err := parsePolicyDocument(s)
+		if err != nil {
+			diags.AddAttributeError(path.Root(attribute).AtListIndex(i), "Unable to parse policy document", err.Error())
+			continue
+		}
+		document = mergePolicyDocuments(document, parsed)
+	}
+	return document, diags
+}
+
+func (d *PolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, diags := d.mergeDocuments(ctx, &policyDocument{}, "source_policy_documents", data.SourcePolicyDocuments)
+	resp.Diagnostics.Append(diags...)
+
+	var statementsData []PolicyDocumentStatement
+	resp.Diagnostics.Append(data.Statements.ElementsAs(ctx, &statementsData, false)...)
+
+	configured := &policyDocument{
+		Version: data.Version.ValueString(),
+		ID:      data.PolicyID.ValueString(),
+	}
+	sids := map[string]bool{}
+	for i, statementData := range statementsData {
+		statement, diags := statementData.toPolicyStatement(ctx)
+		resp.Diagnostics.Append(diags...)
+
+		if statement.Sid != "" {
+			if sids[statement.Sid] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("statement").AtListIndex(i).AtName("sid"),
+					"Duplicate statement sid",
+					fmt.Sprintf("Statement sid %s is used in multiple statement blocks. The sid must be unique within the statement blocks.", statement.Sid),
+				)
+			}
+			sids[statement.Sid] = true
+		}
+		configured.Statement = append(configured.Statement, statement)
+	}
+	document = mergePolicyDocuments(document, configured)
+
+	document, diags = d.mergeDocuments(ctx, document, "override_policy_documents", data.OverridePolicyDocuments)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if document.Version == "" {
+		document.Version = defaultPolicyVersion
+	}
+	if document.Statement == nil {
+		document.Statement = []*policyStatement{}
+	}
+
+	marshaled, err := json.Marshal(document)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to marshal policy document", err.Error())
+		return
+	}
+
+	normalized, diags := normalizePolicyDocument(string(marshaled))
+	resp.Diagnostics.Append(diags...)
+
+	data.Version = types.StringValue(document.Version)
+	data.JSON = types.StringValue(normalized)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePolicyDocuments(t *testing.T) {
+	base, err := parsePolicyDocument(`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"},{"Effect":"Deny","Action":["s3:DeleteObject"]}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, policyPrincipals{"AWS": {"*"}}, base.Statement[0].Principal)
+	assert.Equal(t, policyStringOrSlice{"s3:GetObject"}, base.Statement[0].Action)
+
+	override, err := parsePolicyDocument(`{"Id":"Override","Statement":[{"Sid":"Read","Effect":"Deny","Action":["s3:GetObject"]},{"Effect":"Allow","Action":["s3:ListBucket"]}]}`)
+	assert.NoError(t, err)
+
+	merged := mergePolicyDocuments(base, override)
+	assert.Equal(t, "2012-10-17", merged.Version)
+	assert.Equal(t, "Override", merged.ID)
+	assert.Len(t, merged.Statement, 3)
+	assert.Equal(t, override.Statement[0], merged.Statement[0])
+	assert.Equal(t, base.Statement[1], merged.Statement[1])
+	assert.Equal(t, override.Statement[1], merged.Statement[2])
+
+	// Base document must not be modified
+	assert.Equal(t, "Allow", base.Statement[0].Effect)
+	assert.Len(t, base.Statement, 2)
+}
+
+func TestParsePolicyDocument_conditionValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		expected  map[string]map[string]policyStringOrSlice
+	}{
+		{
+			name:      "String",
+			condition: `{"StringEquals":{"s3:prefix":"home/"}}`,
+			expected:  map[string]map[string]policyStringOrSlice{"StringEquals": {"s3:prefix": {"home/"}}},
+		},
+		{
+			name:      "Bool",
+			condition: `{"Bool":{"aws:SecureTransport":false}}`,
+			expected:  map[string]map[string]policyStringOrSlice{"Bool": {"aws:SecureTransport": {"false"}}},
+		},
+		{
+			name:      "Number",
+			condition: `{"NumericLessThan":{"s3:max-keys":10}}`,
+			expected:  map[string]map[string]policyStringOrSlice{"NumericLessThan": {"s3:max-keys": {"10"}}},
+		},
+		{
+			name:      "Mixed list",
+			condition: `{"NumericEquals":{"s3:max-keys":[10,"20",1.5]}}`,
+			expected:  map[string]map[string]policyStringOrSlice{"NumericEquals": {"s3:max-keys": {"10", "20", "1.5"}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			document, err := parsePolicyDocument(fmt.Sprintf(`{"Statement":[{"Effect":"Deny","Action":"s3:*","Condition":%s}]}`, test.condition))
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, document.Statement[0].Condition)
+		})
+	}
+
+	_, err := parsePolicyDocument(`{"Statement":[{"Effect":"Deny","Action":"s3:*","Condition":{"Bool":{"aws:SecureTransport":{"value":false}}}}]}`)
+	assert.Error(t, err)
+}
+
+func TestAccPolicyDocumentDataSource(t *testing.T) {
+	bucket_name := withSuffix("policy-document-data-source")
+	variables := map[string]config.Variable{
+		"bucket_name": config.StringVariable(bucket_name),
+	}
+	arn := bucketARN(bucket_name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigFile:      config.StaticFile("testdata/policy_document_data_source.tf"),
+				ConfigVariables: variables,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.objsto_policy_document.this", "version", defaultPolicyVersion),
+					resource.TestCheckResourceAttr("data.objsto_policy_document.this", "json", fmt.Sprintf(
+						`{"Id":"PublicRead","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Principal":{"AWS":["*"]},"Resource":["%[1]s/*"],"Sid":"GetObject"},{"Action":["s3:GetBucketLocation","s3:ListBucket"],"Effect":"Allow","Principal":{"AWS":["*"]},"Resource":["%[1]s"],"Sid":"ListBucket"}],"Version":"2012-10-17"}`,
+						arn,
+					)),
+					checkGetUrl("objsto_object.this", "url", 200),
+				),
+			},
+		},
+	})
+}
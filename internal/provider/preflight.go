@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const preflightTimeout = 30 * time.Second
+
+const preflightSkipHint = "The pre-flight check can be disabled with `skip_preflight_check` provider attribute."
+
+// preflightCheck verifies that the object storage service can be reached with the configured endpoint, TLS settings and credentials. If bucket is set, the check is done with HeadBucket on that bucket, and otherwise by listing the buckets.
+func preflightCheck(ctx context.Context, client *s3.Client, bucket types.String) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	var err error
+	if bucket.IsNull() {
+		_, err = client.ListBuckets(ctx, &s3.ListBucketsInput{MaxBuckets: aws.Int32(1)})
+	} else {
+		_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: bucket.ValueStringPointer()})
+	}
+	if err != nil {
+		return preflightErrorToDiagnostics(err, !bucket.IsNull())
+	}
+	return nil
+}
+
+// preflightErrorToDiagnostics converts the pre-flight check error into a diagnostic that points to the attribute most likely causing the error.
+func preflightErrorToDiagnostics(err error, bucketCheck bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	detail := func(hint string) string {
+		return fmt.Sprintf("%s %s\n\nError: %s", hint, preflightSkipHint, err.Error())
+	}
+
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		certificateError x509.CertificateInvalidError
+		hostnameError    x509.HostnameError
+		recordError      tls.RecordHeaderError
+		apiError         smithy.APIError
+		dnsError         *net.DNSError
+		netError         *net.OpError
+	)
+	switch {
+	case errors.As(err, &unknownAuthority), errors.As(err, &certificateError):
+		diags.AddAttributeError(path.Root("ca_bundle"), "Unable to verify the certificate of the object storage service", detail("Check that the certificate of the endpoint is signed by a certificate authority included in `ca_bundle` or in the system certificate pool."))
+	case errors.As(err, &hostnameError):
+		diags.AddAttributeError(path.Root("endpoint"), "Certificate of the object storage service does not match the endpoint", detail("Check that the host name in `endpoint` matches the certificate of the object storage service."))
+	case errors.As(err, &recordError):
+		diags.AddAttributeError(path.Root("endpoint"), "Unable to establish a TLS connection to the object storage service", detail("Check that the endpoint supports HTTPS or use `http://` scheme in `endpoint`."))
+	case errors.As(err, &apiError):
+		switch apiError.ErrorCode() {
+		case "RequestTimeTooSkewed":
+			diags.AddError("Clock skew too large", detail("The difference between the local clock and the clock of the object storage service is too large. Synchronize the local clock, e.g., with NTP."))
+		case "SignatureDoesNotMatch":
+			diags.AddAttributeError(path.Root("secret_key"), "Invalid request signature", detail("Check that `secret_key` matches the `access_key`."))
+		case "InvalidAccessKeyId":
+			diags.AddAttributeError(path.Root("access_key"), "Invalid access key", detail("Check that `access_key` exists in the object storage service."))
+		case "AuthorizationHeaderMalformed":
+			diags.AddAttributeError(path.Root("region"), "Invalid region", detail("Check that `region` matches the region of the object storage service."))
+		case "AccessDenied":
+			// The request was authenticated, but the credentials are not allowed to list buckets.
+			if !bucketCheck {
+				return diags
+			}
+			diags.AddAttributeError(path.Root("preflight_bucket"), "Access denied to pre-flight bucket", detail("Check that the credentials are allowed to access the bucket defined in `preflight_bucket`."))
+		case "Forbidden":
+			// HeadBucket responses do not include an error code, so invalid credentials can not be distinguished from missing permissions.
+			diags.AddAttributeError(path.Root("access_key"), "Access denied to pre-flight bucket", detail("Check that `access_key` and `secret_key` are valid and allowed to access the bucket defined in `preflight_bucket`."))
+		case "NotFound", "NoSuchBucket":
+			diags.AddAttributeError(path.Root("preflight_bucket"), "Pre-flight bucket not found", detail("Check that the bucket defined in `preflight_bucket` exists."))
+		default:
+			diags.AddError("Pre-flight check failed", detail("Check the endpoint and credentials of the provider."))
+		}
+	case errors.As(err, &dnsError), errors.As(err, &netError):
+		diags.AddAttributeError(path.Root("endpoint"), "Unable to connect to the object storage service", detail("Check that `endpoint` is correct and reachable from this host."))
+	default:
+		diags.AddError("Pre-flight check failed", detail("Check the endpoint and credentials of the provider."))
+	}
+	return diags
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreflightCheck(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	var status int
+	var code string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`<ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+			return
+		}
+		w.WriteHeader(status)
+		if r.Method != http.MethodHead {
+			_, _ = fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(tlsServer.Close)
+
+	closedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closedServer.Close()
+
+	// Disable retries to keep the failing checks fast.
+	retry := types.ObjectValueMust(
+		map[string]attr.Type{
+			"max_attempts":          types.Int64Type,
+			"max_backoff":           types.StringType,
+			"mode":                  types.StringType,
+			"retryable_error_codes": types.SetType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"max_attempts":          types.Int64Value(1),
+			"max_backoff":           types.StringNull(),
+			"mode":                  types.StringNull(),
+			"retryable_error_codes": types.SetNull(types.StringType),
+		},
+	)
+
+	tests := []struct {
+		name              string
+		endpoint          string
+		bucket            types.String
+		status            int
+		code              string
+		expectedError     bool
+		expectedAttribute path.Path
+	}{
+		{
+			name:     "Valid configuration",
+			endpoint: server.URL,
+			bucket:   types.StringNull(),
+			status:   http.StatusOK,
+		},
+		{
+			name:     "Not allowed to list buckets",
+			endpoint: server.URL,
+			bucket:   types.StringNull(),
+			status:   http.StatusForbidden,
+			code:     "AccessDenied",
+		},
+		{
+			name:              "Invalid signature",
+			endpoint:          server.URL,
+			bucket:            types.StringNull(),
+			status:            http.StatusForbidden,
+			code:              "SignatureDoesNotMatch",
+			expectedError:     true,
+			expectedAttribute: path.Root("secret_key"),
+		},
+		{
+			name:              "Invalid access key",
+			endpoint:          server.URL,
+			bucket:            types.StringNull(),
+			status:            http.StatusForbidden,
+			code:              "InvalidAccessKeyId",
+			expectedError:     true,
+			expectedAttribute: path.Root("access_key"),
+		},
+		{
+			name:          "Clock skew",
+			endpoint:      server.URL,
+			bucket:        types.StringNull(),
+			status:        http.StatusForbidden,
+			code:          "RequestTimeTooSkewed",
+			expectedError: true,
+		},
+		{
+			name:              "Missing pre-flight bucket",
+			endpoint:          server.URL,
+			bucket:            types.StringValue("missing"),
+			status:            http.StatusNotFound,
+			expectedError:     true,
+			expectedAttribute: path.Root("preflight_bucket"),
+		},
+		{
+			name:              "Forbidden pre-flight bucket",
+			endpoint:          server.URL,
+			bucket:            types.StringValue("forbidden"),
+			status:            http.StatusForbidden,
+			expectedError:     true,
+			expectedAttribute: path.Root("access_key"),
+		},
+		{
+			name:              "Unknown certificate authority",
+			endpoint:          tlsServer.URL,
+			bucket:            types.StringNull(),
+			expectedError:     true,
+			expectedAttribute: path.Root("ca_bundle"),
+		},
+		{
+			name:              "Unreachable endpoint",
+			endpoint:          closedServer.URL,
+			bucket:            types.StringNull(),
+			expectedError:     true,
+			expectedAttribute: path.Root("endpoint"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status = test.status
+			code = test.code
+
+			client, diags := getClient(ctx, ObjStoProviderModel{
+				Endpoint:  types.StringValue(test.endpoint),
+				Region:    types.StringValue("localhost"),
+				AccessKey: types.StringValue("access_key"),
+				SecretKey: types.StringValue("secret_key"),
+				Retry:     retry,
+			})
+			assert.False(t, diags.HasError(), diags)
+
+			diags = preflightCheck(ctx, client, test.bucket)
+			assert.Equal(t, test.expectedError, diags.HasError(), diags)
+			if len(test.expectedAttribute.Steps()) == 0 {
+				return
+			}
+			if assert.Len(t, diags, 1) {
+				d, ok := diags[0].(diag.DiagnosticWithPath)
+				if assert.True(t, ok, "expected diagnostic with path") {
+					assert.Equal(t, test.expectedAttribute, d.Path())
+				}
+			}
+		})
+	}
+}
@@ -4,11 +4,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +30,7 @@ const (
 	envKeyRegion    string = "OBJSTO_REGION"
 	envKeyAccessKey string = "OBJSTO_ACCESS_KEY"
 	envKeySecretKey string = "OBJSTO_SECRET_KEY"
+	envKeyToken     string = "OBJSTO_SESSION_TOKEN"
 )
 
 // Ensure ObjStoProvider satisfies various provider interfaces.
@@ -40,6 +50,54 @@ type ObjStoProviderModel struct {
 	Region    types.String `tfsdk:"region"`
 	AccessKey types.String `tfsdk:"access_key"`
 	SecretKey types.String `tfsdk:"secret_key"`
+	Token     types.String `tfsdk:"token"`
+	Profile   types.String `tfsdk:"profile"`
+
+	CredentialProcess types.String `tfsdk:"credential_process"`
+	UsePathStyle      types.Bool   `tfsdk:"use_path_style"`
+
+	CABundle           types.String `tfsdk:"ca_bundle"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	HTTPProxy           types.String `tfsdk:"http_proxy"`
+	HTTPSProxy          types.String `tfsdk:"https_proxy"`
+	NoProxy             types.String `tfsdk:"no_proxy"`
+	MaxIdleConnsPerHost types.Int64  `tfsdk:"max_idle_conns_per_host"`
+	IdleConnTimeout     types.String `tfsdk:"idle_conn_timeout"`
+	DialTimeout         types.String `tfsdk:"dial_timeout"`
+	TLSHandshakeTimeout types.String `tfsdk:"tls_handshake_timeout"`
+	DisableHTTP2        types.Bool   `tfsdk:"disable_http2"`
+
+	SharedConfigFiles      types.List `tfsdk:"shared_config_files"`
+	SharedCredentialsFiles types.List `tfsdk:"shared_credentials_files"`
+
+	SkipPreflightCheck types.Bool   `tfsdk:"skip_preflight_check"`
+	PreflightBucket    types.String `tfsdk:"preflight_bucket"`
+	LogMode            types.String `tfsdk:"log_mode"`
+
+	AssumeRole                types.Object `tfsdk:"assume_role"`
+	AssumeRoleWithWebIdentity types.Object `tfsdk:"assume_role_with_web_identity"`
+	Retry                     types.Object `tfsdk:"retry"`
+
+	DefaultTags types.Object `tfsdk:"default_tags"`
+	IgnoreTags  types.Object `tfsdk:"ignore_tags"`
+}
+
+type DefaultTags struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+type IgnoreTags struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+// objStoProviderData is passed to resources and data sources when they are configured.
+type objStoProviderData struct {
+	client *s3.Client
+	tags   tagsConfig
 }
 
 func (p *ObjStoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -67,54 +125,304 @@ func (p *ObjStoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "The `objsto` provider is used to manage S3 compatible object storage services such as [UpCloud Managed Object Storage](https://upcloud.com/products/object-storage). The provider manages the resources using the S3 API of the target object storage service.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: envAlternative("S3 endpoint of the object storage service", envKeyEndpoint),
+				MarkdownDescription: envAlternative("S3 endpoint of the object storage service", envKeyEndpoint) + " Defaults to `AWS_ENDPOINT_URL_S3` or `AWS_ENDPOINT_URL` environment variable or `endpoint_url` of the shared config profile.",
 				Optional:            true,
-				Validators: []validator.String{
-					NewValueOrEnvValidator(envKeyEndpoint),
-				},
 			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: envAlternative("Region of the object storage service", envKeyRegion),
+				MarkdownDescription: envAlternative("Region of the object storage service", envKeyRegion) + " Defaults to `AWS_REGION` environment variable or `region` of the shared config profile.",
+				Optional:            true,
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: envAlternative("Access key for the object storage service", envKeyAccessKey) + " If access key and secret key are not defined, the credentials are loaded from the standard AWS credential chain, i.e., `AWS_ACCESS_KEY_ID` and `AWS_SECRET_ACCESS_KEY` environment variables or the shared credentials and config files.",
+				Optional:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: envAlternative("Secret key for the object storage service", envKeySecretKey),
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: envAlternative("Session token for the object storage service. Required when using temporary credentials", envKeyToken),
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "Command to run to load the credentials, e.g., from a secret manager. The command must print the credentials in the JSON format used by `credential_process` of the AWS CLI. The command is run again when the credentials expire. Conflicts with `access_key` and `secret_key`.",
 				Optional:            true,
 				Validators: []validator.String{
-					NewValueOrEnvValidator(envKeyRegion),
+					stringvalidator.ConflictsWith(path.MatchRoot("access_key"), path.MatchRoot("secret_key")),
 				},
 			},
-			"access_key": schema.StringAttribute{
-				MarkdownDescription: envAlternative("Access key for the object storage service", envKeyAccessKey),
+			"use_path_style": schema.BoolAttribute{
+				MarkdownDescription: "Whether to use path-style addressing, e.g., `https://endpoint/bucket/key`, instead of virtual-hosted-style addressing, e.g., `https://bucket.endpoint/key`, in API requests and computed URLs. Defaults to `true`.",
+				Optional:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates, or path to a file that contains them, to trust in addition to the system CA certificates when verifying the certificate of the object storage service.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or path to a file that contains it, to use for mutual TLS authentication. Requires `client_key`.",
 				Optional:            true,
 				Validators: []validator.String{
-					NewValueOrEnvValidator(envKeyAccessKey),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
 				},
 			},
-			"secret_key": schema.StringAttribute{
-				MarkdownDescription: envAlternative("Secret key for the object storage service", envKeySecretKey),
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate, or path to a file that contains it. Requires `client_certificate`.",
 				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
-					NewValueOrEnvValidator(envKeySecretKey),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verifying the certificate of the object storage service. This makes the connection vulnerable to man-in-the-middle attacks and should only be used for testing.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to use for HTTP requests. Defaults to `HTTP_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to use for HTTPS requests. Defaults to `HTTPS_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of hosts, domains, and IP ranges that should not be accessed through the proxy. Defaults to `NO_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"max_idle_conns_per_host": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of idle connections to keep open to the object storage service for reuse.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idle_conn_timeout": schema.StringAttribute{
+				MarkdownDescription: "Duration after which idle connections are closed, e.g., `90s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"dial_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for establishing a connection to the object storage service, e.g., `30s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"tls_handshake_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for the TLS handshake with the object storage service, e.g., `10s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"disable_http2": schema.BoolAttribute{
+				MarkdownDescription: "Disable HTTP/2 and use only HTTP/1.1 when connecting to the object storage service.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile to use from the shared credentials and config files. Can also be configured with `AWS_PROFILE` environment variable.",
+				Optional:            true,
+			},
+			"shared_config_files": schema.ListAttribute{
+				MarkdownDescription: "Paths to the shared config files. Defaults to `~/.aws/config`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"shared_credentials_files": schema.ListAttribute{
+				MarkdownDescription: "Paths to the shared credentials files. Defaults to `~/.aws/credentials`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"skip_preflight_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip the pre-flight check that verifies the endpoint, TLS configuration and credentials when the provider is configured. By default, the provider lists the buckets, or checks the bucket defined in `preflight_bucket`, before any resources are managed.",
+				Optional:            true,
+			},
+			"preflight_bucket": schema.StringAttribute{
+				MarkdownDescription: "Name of the bucket to check in the pre-flight check instead of listing the buckets. Useful when the credentials are not allowed to list buckets.",
+				Optional:            true,
+			},
+			"log_mode": schema.StringAttribute{
+				MarkdownDescription: "Defines what is logged about the API requests when debug logging is enabled with `TF_LOG`. Valid values are `none`, `retries` (retry attempts), `headers` (retry attempts, and method, URL, headers, and status of each request), and `bodies` (`headers` and request and response bodies). Authorization headers, security tokens, and server-side encryption keys are masked, and object content is never logged. Defaults to `headers`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(logModeNone, logModeRetries, logModeHeaders, logModeBodies),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"assume_role":                   assumeRoleBlock(),
+			"assume_role_with_web_identity": assumeRoleWithWebIdentityBlock(),
+			"retry":                         retryBlock(),
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Default tags to assign to all resources that support tagging. Tags configured in the resource override default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Optional:            true,
+						MarkdownDescription: "The tags to assign to the resources.",
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags to ignore when reading tags of resources. Use this to ignore tags that are managed outside of Terraform, for example by the object storage service or other tools.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Optional:            true,
+						MarkdownDescription: "Tag keys to ignore.",
+						ElementType:         types.StringType,
+					},
+					"key_prefixes": schema.SetAttribute{
+						Optional:            true,
+						MarkdownDescription: "Tag key prefixes to ignore.",
+						ElementType:         types.StringType,
+					},
 				},
 			},
 		},
 	}
 }
 
-func getClient(ctx context.Context, data ObjStoProviderModel) *s3.Client {
-	endpoint := withEnvDefault(data.Endpoint, envKeyEndpoint)
-	client := s3.New(s3.Options{
-		BaseEndpoint:  &endpoint,
-		ClientLogMode: aws.LogRetries | aws.LogRequestWithBody | aws.LogResponseWithBody,
-		Credentials: aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
-			withEnvDefault(data.AccessKey, envKeyAccessKey),
-			withEnvDefault(data.SecretKey, envKeySecretKey),
-			"",
-		)),
-		Logger:       logger{ctx: ctx},
-		UsePathStyle: true,
-		Region:       withEnvDefault(data.Region, envKeyRegion),
-	})
+// expandHomeDir replaces the leading `~` in the path with the home directory of the current user.
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
 
-	return client
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+func listToPaths(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	var paths []string
+	diags := list.ElementsAs(ctx, &paths, false)
+	for i, path := range paths {
+		paths[i] = expandHomeDir(path)
+	}
+	return paths, diags
+}
+
+func getClient(ctx context.Context, data ObjStoProviderModel) (*s3.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	logMode := data.LogMode.ValueString()
+	if data.LogMode.IsNull() {
+		logMode = logModeHeaders
+	}
+
+	opts := []func(*config.LoadOptions) error{
+		config.WithClientLogMode(getClientLogMode(logMode)),
+		config.WithLogger(logger{ctx: ctx}),
+		// Instance metadata is not available outside of AWS, so do not try to load credentials or region from it.
+		config.WithEC2IMDSClientEnableState(imds.ClientDisabled),
+	}
+
+	if !data.SharedConfigFiles.IsNull() {
+		files, d := listToPaths(ctx, data.SharedConfigFiles)
+		diags.Append(d...)
+		opts = append(opts, config.WithSharedConfigFiles(files))
+	}
+
+	if !data.SharedCredentialsFiles.IsNull() {
+		files, d := listToPaths(ctx, data.SharedCredentialsFiles)
+		diags.Append(d...)
+		opts = append(opts, config.WithSharedCredentialsFiles(files))
+	}
+
+	if profile := data.Profile.ValueString(); profile != "" {
+		opts = append(opts, config.WithSharedConfigProfile(profile))
+	}
+
+	if region := withEnvDefault(data.Region, envKeyRegion); region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+
+	accessKey := withEnvDefault(data.AccessKey, envKeyAccessKey)
+	secretKey := withEnvDefault(data.SecretKey, envKeySecretKey)
+	switch {
+	case !data.CredentialProcess.IsNull():
+		opts = append(opts, config.WithCredentialsProvider(processcreds.NewProvider(data.CredentialProcess.ValueString())))
+	case accessKey != "" && secretKey != "":
+		token := withEnvDefault(data.Token, envKeyToken)
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKey, secretKey, token)))
+	case accessKey != "":
+		diags.AddAttributeError(path.Root("secret_key"), "Missing secret key", fmt.Sprintf("Secret key must be defined either in the configuration or with the %s environment variable when access key is defined.", envKeySecretKey))
+	case secretKey != "":
+		diags.AddAttributeError(path.Root("access_key"), "Missing access key", fmt.Sprintf("Access key must be defined either in the configuration or with the %s environment variable when secret key is defined.", envKeyAccessKey))
+	}
+
+	httpClient, d := getHTTPClient(data)
+	diags.Append(d...)
+	opts = append(opts, config.WithHTTPClient(httpClient))
+
+	if !data.Retry.IsNull() {
+		retryer, d := getRetryer(ctx, data.Retry)
+		diags.Append(d...)
+		opts = append(opts, config.WithRetryer(retryer))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		diags.AddError("Unable to load object storage configuration", err.Error())
+		return nil, diags
+	}
+
+	if cfg.Region == "" {
+		diags.AddAttributeError(path.Root("region"), "Missing region", fmt.Sprintf("Region must be defined in the configuration, with the %s or AWS_REGION environment variable, or in the shared config file.", envKeyRegion))
+		return nil, diags
+	}
+
+	withOptions := func(o *s3.Options) {
+		if endpoint := withEnvDefault(data.Endpoint, envKeyEndpoint); endpoint != "" {
+			o.BaseEndpoint = &endpoint
+		}
+		o.UsePathStyle = data.UsePathStyle.IsNull() || data.UsePathStyle.ValueBool()
+		// The requests are logged only for the S3 client, the STS requests and responses contain credentials.
+		o.APIOptions = append(o.APIOptions, getLogMiddlewares(logMode)...)
+	}
+	client := s3.NewFromConfig(cfg, withOptions)
+
+	if client.Options().BaseEndpoint == nil {
+		diags.AddAttributeError(path.Root("endpoint"), "Missing endpoint", fmt.Sprintf("Endpoint must be defined in the configuration, with the %s or AWS_ENDPOINT_URL environment variable, or with endpoint_url in the shared config file.", envKeyEndpoint))
+		return nil, diags
+	}
+
+	var roleCredentials aws.CredentialsProvider
+	switch {
+	case !data.AssumeRole.IsNull():
+		roleCredentials, d = getAssumeRoleCredentials(ctx, cfg, data.AssumeRole, *client.Options().BaseEndpoint)
+	case !data.AssumeRoleWithWebIdentity.IsNull():
+		roleCredentials, d = getAssumeRoleWithWebIdentityCredentials(ctx, cfg, data.AssumeRoleWithWebIdentity, *client.Options().BaseEndpoint)
+	}
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if roleCredentials != nil {
+		cfg.Credentials = roleCredentials
+		client = s3.NewFromConfig(cfg, withOptions)
+	}
+
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+		diags.AddError("Unable to load object storage credentials", err.Error())
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return client, diags
 }
 
 func (p *ObjStoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -125,10 +433,35 @@ func (p *ObjStoProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	client := getClient(ctx, data)
+	tags, diags := getTagsConfig(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	client, diags := getClient(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SkipPreflightCheck.ValueBool() {
+		resp.Diagnostics.Append(preflightCheck(ctx, client, data.PreflightBucket)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	providerData := &objStoProviderData{
+		client: client,
+		tags:   tags,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *ObjStoProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -139,11 +472,19 @@ func (p *ObjStoProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewBucketPolicyResource,
 		NewBucketVersioningResource,
 		NewObjectResource,
+		NewObjectTaggingResource,
 	}
 }
 
 func (p *ObjStoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewBucketDataSource,
+		NewBucketsDataSource,
+		NewObjectDataSource,
+		NewObjectsDataSource,
+		NewObjectVersionsDataSource,
+		NewPolicyDocumentDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -1,10 +1,23 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -13,3 +26,151 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 
 func testAccPreCheck(t *testing.T) {
 }
+
+// testAccClient returns a client configured with the environment variables used by the acceptance tests.
+func testAccClient(ctx context.Context) *s3.Client {
+	client, diags := getClient(ctx, ObjStoProviderModel{})
+	if diags.HasError() {
+		panic(fmt.Sprintf("failed to configure client: %v", diags))
+	}
+	return client
+}
+
+func clearClientEnv(t *testing.T) {
+	for _, key := range []string{
+		envKeyEndpoint,
+		envKeyRegion,
+		envKeyAccessKey,
+		envKeySecretKey,
+		"AWS_ACCESS_KEY_ID",
+		"AWS_SECRET_ACCESS_KEY",
+		"AWS_SESSION_TOKEN",
+		"AWS_PROFILE",
+		"AWS_REGION",
+		"AWS_DEFAULT_REGION",
+		"AWS_ENDPOINT_URL",
+		"AWS_ENDPOINT_URL_S3",
+		"HTTP_PROXY",
+		"HTTPS_PROXY",
+		"NO_PROXY",
+		"http_proxy",
+		"https_proxy",
+		"no_proxy",
+	} {
+		t.Setenv(key, "")
+	}
+
+	// Do not load the shared config and credentials files of the current user.
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+}
+
+// newTestClient returns a client that sends the requests to a test server serving the requests with the handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *s3.Client {
+	clearClientEnv(t)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, diags := getClient(t.Context(), ObjStoProviderModel{
+		Endpoint:  types.StringValue(server.URL),
+		Region:    types.StringValue("localhost"),
+		AccessKey: types.StringValue("access_key"),
+		SecretKey: types.StringValue("secret_key"),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to configure client: %v", diags)
+	}
+	return client
+}
+
+// getResourceSchema returns the schema of the resource and a null timeouts value matching its timeouts block.
+func getResourceSchema(t *testing.T, r resource.Resource) (schema.Schema, timeouts.Value) {
+	var resp resource.SchemaResponse
+	r.Schema(t.Context(), resource.SchemaRequest{}, &resp)
+
+	timeoutsType := resp.Schema.Blocks["timeouts"].Type().(timeouts.Type)
+	return resp.Schema, timeouts.Value{Object: types.ObjectNull(timeoutsType.AttrTypes)}
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGetClient(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	configFile := writeTestFile(t, "config", `
+[profile objsto]
+region = fi-hel2
+endpoint_url = https://objsto.example.com
+`)
+	credentialsFile := writeTestFile(t, "credentials", `
+[objsto]
+aws_access_key_id = profile_access_key
+aws_secret_access_key = profile_secret_key
+`)
+	files := func(path string) types.List {
+		return types.ListValueMust(types.StringType, []attr.Value{types.StringValue(path)})
+	}
+
+	t.Run("profile", func(t *testing.T) {
+		client, diags := getClient(ctx, ObjStoProviderModel{
+			Profile:                types.StringValue("objsto"),
+			SharedConfigFiles:      files(configFile),
+			SharedCredentialsFiles: files(credentialsFile),
+		})
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, "fi-hel2", client.Options().Region)
+		assert.Equal(t, "https://objsto.example.com", *client.Options().BaseEndpoint)
+		assert.True(t, client.Options().UsePathStyle)
+
+		creds, err := client.Options().Credentials.Retrieve(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "profile_access_key", creds.AccessKeyID)
+	})
+
+	t.Run("configuration overrides profile", func(t *testing.T) {
+		client, diags := getClient(ctx, ObjStoProviderModel{
+			Endpoint:               types.StringValue("http://localhost:9000"),
+			Region:                 types.StringValue("localhost"),
+			AccessKey:              types.StringValue("access_key"),
+			SecretKey:              types.StringValue("secret_key"),
+			UsePathStyle:           types.BoolValue(false),
+			Profile:                types.StringValue("objsto"),
+			SharedConfigFiles:      files(configFile),
+			SharedCredentialsFiles: files(credentialsFile),
+		})
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, "localhost", client.Options().Region)
+		assert.Equal(t, "http://localhost:9000", *client.Options().BaseEndpoint)
+		assert.False(t, client.Options().UsePathStyle)
+
+		creds, err := client.Options().Credentials.Retrieve(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "access_key", creds.AccessKeyID)
+	})
+
+	t.Run("missing endpoint", func(t *testing.T) {
+		_, diags := getClient(ctx, ObjStoProviderModel{
+			Region:    types.StringValue("localhost"),
+			AccessKey: types.StringValue("access_key"),
+			SecretKey: types.StringValue("secret_key"),
+		})
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Missing endpoint", diags.Errors()[0].Summary())
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		_, diags := getClient(ctx, ObjStoProviderModel{
+			AccessKey: types.StringValue("access_key"),
+		})
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Missing secret key", diags.Errors()[0].Summary())
+	})
+}
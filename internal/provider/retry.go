@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	retryModeStandard string = "standard"
+	retryModeAdaptive string = "adaptive"
+)
+
+type Retry struct {
+	MaxAttempts         types.Int64  `tfsdk:"max_attempts"`
+	MaxBackoff          types.String `tfsdk:"max_backoff"`
+	Mode                types.String `tfsdk:"mode"`
+	RetryableErrorCodes types.Set    `tfsdk:"retryable_error_codes"`
+}
+
+func retryBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Configure how failed requests are retried. Throttling errors, such as `SlowDown`, and 5xx errors are retried by default.",
+		Attributes: map[string]schema.Attribute{
+			"max_attempts": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of attempts for each request, including the initial attempt. Defaults to 3.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_backoff": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum delay between attempts, e.g., `30s`. Defaults to 20 seconds.",
+				Validators: []validator.String{
+					isValidDuration{},
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The retry mode. Valid values are `standard` and `adaptive`. In `adaptive` mode, the request rate is also limited client-side when the service responds with throttling errors. Defaults to `standard`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(retryModeStandard, retryModeAdaptive),
+				},
+			},
+			"retryable_error_codes": schema.SetAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional error codes to retry, e.g., backend-specific error codes used by the object storage service when it is temporarily unavailable.",
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// getRetryer returns a function that creates the retryer configured in the retry block.
+func getRetryer(ctx context.Context, data types.Object) (func() aws.Retryer, diag.Diagnostics) {
+	var retryData Retry
+	diags := data.As(ctx, &retryData, objectAsOptions)
+
+	var errorCodes []string
+	if !retryData.RetryableErrorCodes.IsNull() {
+		diags.Append(retryData.RetryableErrorCodes.ElementsAs(ctx, &errorCodes, false)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	standardOptions := func(o *retry.StandardOptions) {
+		if !retryData.MaxAttempts.IsNull() {
+			o.MaxAttempts = int(retryData.MaxAttempts.ValueInt64())
+		}
+		if !retryData.MaxBackoff.IsNull() {
+			o.MaxBackoff = parseDuration(retryData.MaxBackoff)
+		}
+		if len(errorCodes) > 0 {
+			codes := retry.RetryableErrorCode{Codes: map[string]struct{}{}}
+			for _, code := range errorCodes {
+				codes.Codes[code] = struct{}{}
+			}
+			o.Retryables = append(o.Retryables, codes)
+		}
+	}
+
+	if retryData.Mode.ValueString() == retryModeAdaptive {
+		return func() aws.Retryer {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standardOptions)
+			})
+		}, diags
+	}
+
+	return func() aws.Retryer {
+		return retry.NewStandard(standardOptions)
+	}, diags
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClient_retry(t *testing.T) {
+	clearClientEnv(t)
+	ctx := t.Context()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`<Error><Code>BackendBusy</Code><Message>Backend is busy</Message></Error>`))
+	}))
+	t.Cleanup(server.Close)
+
+	retryBlock := func(mode string, errorCodes ...string) types.Object {
+		codes := []attr.Value{}
+		for _, code := range errorCodes {
+			codes = append(codes, types.StringValue(code))
+		}
+		return types.ObjectValueMust(
+			map[string]attr.Type{
+				"max_attempts":          types.Int64Type,
+				"max_backoff":           types.StringType,
+				"mode":                  types.StringType,
+				"retryable_error_codes": types.SetType{ElemType: types.StringType},
+			},
+			map[string]attr.Value{
+				"max_attempts":          types.Int64Value(4),
+				"max_backoff":           types.StringValue("1ms"),
+				"mode":                  types.StringValue(mode),
+				"retryable_error_codes": types.SetValueMust(types.StringType, codes),
+			},
+		)
+	}
+
+	tests := []struct {
+		name             string
+		retry            types.Object
+		expectedRequests int
+	}{
+		{
+			name:             "Standard mode without additional error codes",
+			retry:            retryBlock(retryModeStandard),
+			expectedRequests: 1,
+		},
+		{
+			name:             "Standard mode",
+			retry:            retryBlock(retryModeStandard, "BackendBusy"),
+			expectedRequests: 4,
+		},
+		{
+			name:             "Adaptive mode",
+			retry:            retryBlock(retryModeAdaptive, "BackendBusy"),
+			expectedRequests: 4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests = 0
+
+			client, diags := getClient(ctx, ObjStoProviderModel{
+				Endpoint:  types.StringValue(server.URL),
+				Region:    types.StringValue("localhost"),
+				AccessKey: types.StringValue("access_key"),
+				SecretKey: types.StringValue("secret_key"),
+				Retry:     test.retry,
+			})
+			assert.False(t, diags.HasError(), diags)
+
+			_, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+			assert.ErrorContains(t, err, "BackendBusy")
+			assert.Equal(t, test.expectedRequests, requests)
+		})
+	}
+}
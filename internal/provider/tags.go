@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Limits of the object tags.
+const (
+	maxObjectTags     = 10
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+)
+
+// tagsConfig contains the provider level tag settings.
+type tagsConfig struct {
+	defaultTags       map[string]string
+	ignoreKeys        []string
+	ignoreKeyPrefixes []string
+}
+
+func getTagsConfig(ctx context.Context, data ObjStoProviderModel) (config tagsConfig, diags diag.Diagnostics) {
+	if !data.DefaultTags.IsNull() {
+		var defaultTags DefaultTags
+		diags.Append(data.DefaultTags.As(ctx, &defaultTags, objectAsOptions)...)
+
+		var d diag.Diagnostics
+		config.defaultTags, d = tagsFromMap(ctx, defaultTags.Tags)
+		diags.Append(d...)
+	}
+
+	if !data.IgnoreTags.IsNull() {
+		var ignoreTags IgnoreTags
+		diags.Append(data.IgnoreTags.As(ctx, &ignoreTags, objectAsOptions)...)
+
+		if !ignoreTags.Keys.IsNull() {
+			diags.Append(ignoreTags.Keys.ElementsAs(ctx, &config.ignoreKeys, false)...)
+		}
+		if !ignoreTags.KeyPrefixes.IsNull() {
+			diags.Append(ignoreTags.KeyPrefixes.ElementsAs(ctx, &config.ignoreKeyPrefixes, false)...)
+		}
+	}
+	return
+}
+
+func (c tagsConfig) isIgnored(key string) bool {
+	if slices.Contains(c.ignoreKeys, key) {
+		return true
+	}
+	for _, prefix := range c.ignoreKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// merge returns the default tags merged with the given resource tags. Resource tags override default tags with the same key.
+func (c tagsConfig) merge(tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(c.defaultTags)+len(tags))
+	maps.Copy(merged, c.defaultTags)
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// isManaged checks whether the tags of the resource need to be read, i.e., the resource or the provider defines tags, or the resource had tags when it was last applied. Reading the tags is skipped otherwise, as not all object storage services support tagging and not all credentials are allowed to read tags.
+func (c tagsConfig) isManaged(tags, tagsAll types.Map) bool {
+	return len(c.defaultTags) > 0 || !tags.IsNull() || len(tagsAll.Elements()) > 0
+}
+
+// withoutIgnored returns the tags without the ignored tags.
+func (c tagsConfig) withoutIgnored(tags map[string]string) map[string]string {
+	filtered := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if !c.isIgnored(key) {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// withIgnoredFrom returns the tags with the ignored tags from the current tags added. This is used to avoid removing ignored tags when replacing the tags of the target.
+func (c tagsConfig) withIgnoredFrom(tags, current map[string]string) map[string]string {
+	combined := maps.Clone(tags)
+	for key, value := range current {
+		if _, ok := combined[key]; !ok && c.isIgnored(key) {
+			combined[key] = value
+		}
+	}
+	return combined
+}
+
+// resourceTags returns the tags that should be stored in the resource's tags attribute: all tags, except the ones inherited from the default tags.
+func (c tagsConfig) resourceTags(tagsAll, configured map[string]string) map[string]string {
+	tags := make(map[string]string, len(tagsAll))
+	for key, value := range tagsAll {
+		_, isConfigured := configured[key]
+		if defaultValue, ok := c.defaultTags[key]; ok && defaultValue == value && !isConfigured {
+			continue
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
+// modifyPlanTagsAll sets the planned tags_all value to the default tags merged with the planned tags value.
+func (c tagsConfig) modifyPlanTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isFullyKnown(tags) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	tagsData, diags := tagsFromMap(ctx, tags)
+	resp.Diagnostics.Append(diags...)
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, c.merge(tagsData))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// objectTagsValidators returns the validators for the count and length limits of object tags. The length limits are in Unicode characters.
+func objectTagsValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.SizeAtMost(maxObjectTags),
+		mapvalidator.KeysAre(stringvalidator.UTF8LengthBetween(1, maxTagKeyLength)),
+		mapvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(maxTagValueLength)),
+	}
+}
+
+// validateObjectTagsAll checks that the planned tags_all value, i.e., the object tags merged with the default tags, does not exceed the object tag count limit.
+func validateObjectTagsAll(ctx context.Context, plan tfsdk.Plan) (diags diag.Diagnostics) {
+	var tagsAll types.Map
+	diags.Append(plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)...)
+	if diags.HasError() || tagsAll.IsUnknown() {
+		return
+	}
+
+	if count := len(tagsAll.Elements()); count > maxObjectTags {
+		diags.AddAttributeError(
+			path.Root("tags"),
+			"Too many tags",
+			fmt.Sprintf("An object can have at most %d tags, but the object would have %d tags including the tags inherited from the provider default_tags configuration.", maxObjectTags, count),
+		)
+	}
+	return
+}
+
+func isFullyKnown(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+	for _, value := range m.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func tagsToS3(tags map[string]string) []s3_types.Tag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	s3Tags := make([]s3_types.Tag, 0, len(tags))
+	for _, key := range keys {
+		value := tags[key]
+		s3Tags = append(s3Tags, s3_types.Tag{
+			Key:   &key,
+			Value: &value,
+		})
+	}
+	return s3Tags
+}
+
+// encodeTags encodes the tags as URL query parameters for the tagging header of the upload and copy requests. Empty tags are encoded as nil.
+func encodeTags(tags map[string]string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	values := url.Values{}
+	for key, value := range tags {
+		values.Set(key, value)
+	}
+	encoded := values.Encode()
+	return &encoded
+}
+
+func tagsFromS3(s3Tags []s3_types.Tag) map[string]string {
+	tags := make(map[string]string, len(s3Tags))
+	for _, tag := range s3Tags {
+		if tag.Key == nil {
+			continue
+		}
+		value := ""
+		if tag.Value != nil {
+			value = *tag.Value
+		}
+		tags[*tag.Key] = value
+	}
+	return tags
+}
+
+func tagsFromMap(ctx context.Context, m types.Map) (tags map[string]string, diags diag.Diagnostics) {
+	tags = make(map[string]string)
+	if m.IsNull() || m.IsUnknown() {
+		return
+	}
+	diags.Append(m.ElementsAs(ctx, &tags, false)...)
+	return
+}
+
+// tagsToMap converts tags into a map value. Empty tags are stored as null, if current value is null, to avoid diffs when tags are not configured.
+func tagsToMap(ctx context.Context, tags map[string]string, current types.Map) (types.Map, diag.Diagnostics) {
+	if len(tags) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, tags)
+}
+
+// isNoSuchTagSet checks whether the error is caused by the target not having any tags.
+func isNoSuchTagSet(err error) bool {
+	var ae smithy.APIError
+	return errors.As(err, &ae) && ae.ErrorCode() == "NoSuchTagSet"
+}
+
+// isNotImplemented checks whether the error is caused by the object storage service not supporting the operation, e.g., tagging.
+func isNotImplemented(err error) bool {
+	var ae smithy.APIError
+	if errors.As(err, &ae) && ae.ErrorCode() == "NotImplemented" {
+		return true
+	}
+	var re *awshttp.ResponseError
+	return errors.As(err, &re) && re.HTTPStatusCode() == http.StatusNotImplemented
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsConfig(t *testing.T) {
+	config := tagsConfig{
+		defaultTags: map[string]string{
+			"env":  "test",
+			"team": "objsto",
+		},
+		ignoreKeys:        []string{"created-by"},
+		ignoreKeyPrefixes: []string{"backend:"},
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"env":  "prod",
+			"team": "objsto",
+			"app":  "web",
+		}, config.merge(map[string]string{
+			"env": "prod",
+			"app": "web",
+		}))
+	})
+
+	t.Run("withoutIgnored", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"env": "test",
+		}, config.withoutIgnored(map[string]string{
+			"env":             "test",
+			"created-by":      "tool",
+			"backend:billing": "123",
+		}))
+	})
+
+	t.Run("withIgnoredFrom", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"env":             "prod",
+			"created-by":      "tool",
+			"backend:billing": "123",
+		}, config.withIgnoredFrom(map[string]string{
+			"env": "prod",
+		}, map[string]string{
+			"env":             "test",
+			"app":             "web",
+			"created-by":      "tool",
+			"backend:billing": "123",
+		}))
+	})
+
+	t.Run("resourceTags", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"team": "objsto",
+			"app":  "web",
+			"env":  "prod",
+		}, config.resourceTags(map[string]string{
+			"env":  "prod",
+			"team": "objsto",
+			"app":  "web",
+		}, map[string]string{
+			"team": "objsto",
+			"app":  "web",
+		}))
+	})
+}
+
+func TestObjectTagsValidators(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name          string
+		tags          map[string]string
+		expectedError bool
+	}{
+		{
+			name: "Non-ASCII tags at the length limits",
+			tags: map[string]string{strings.Repeat("ä", maxTagKeyLength): strings.Repeat("ö", maxTagValueLength)},
+		},
+		{
+			name:          "Too long key",
+			tags:          map[string]string{strings.Repeat("ä", maxTagKeyLength+1): "value"},
+			expectedError: true,
+		},
+		{
+			name:          "Too long value",
+			tags:          map[string]string{"key": strings.Repeat("ö", maxTagValueLength+1)},
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, diags := types.MapValueFrom(ctx, types.StringType, test.tags)
+			assert.False(t, diags.HasError(), diags)
+
+			var resp validator.MapResponse
+			for _, v := range objectTagsValidators() {
+				v.ValidateMap(ctx, validator.MapRequest{Path: path.Root("tags"), ConfigValue: value}, &resp)
+			}
+			assert.Equal(t, test.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+
+  tags = {
+    env = "test"
+  }
+}
+
+resource "objsto_bucket_versioning" "this" {
+  bucket = objsto_bucket.this.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+data "objsto_bucket" "this" {
+  bucket = objsto_bucket_versioning.this.bucket
+}
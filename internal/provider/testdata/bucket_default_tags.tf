@@ -0,0 +1,29 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "default_tags" {
+  type    = map(string)
+  default = {}
+}
+
+variable "tags" {
+  type    = map(string)
+  default = null
+}
+
+provider "objsto" {
+  default_tags {
+    tags = var.default_tags
+  }
+
+  ignore_tags {
+    key_prefixes = ["ignored:"]
+  }
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+  tags   = var.tags
+}
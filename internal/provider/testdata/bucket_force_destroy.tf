@@ -0,0 +1,21 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  bucket        = var.bucket_name
+  force_destroy = true
+
+  timeouts {
+    delete = "10m"
+  }
+}
+
+resource "objsto_bucket_versioning" "this" {
+  bucket = objsto_bucket.this.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
@@ -0,0 +1,14 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "tags" {
+  type    = map(string)
+  default = null
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+  tags   = var.tags
+}
@@ -0,0 +1,22 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  for_each = toset(["a", "b"])
+
+  bucket = "${var.bucket_name}-${each.value}"
+}
+
+data "objsto_buckets" "prefix" {
+  prefix = var.bucket_name
+
+  depends_on = [objsto_bucket.this]
+}
+
+data "objsto_buckets" "regex" {
+  name_regex = "^${var.bucket_name}-a$"
+
+  depends_on = [objsto_bucket.this]
+}
@@ -0,0 +1,19 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "content_base64" {
+  type    = string
+  default = "H4sIAAAAAAAA/wMAAAAAAAAAAAA="
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket         = objsto_bucket.this.bucket
+  key            = "empty.gz"
+  content_base64 = var.content_base64
+}
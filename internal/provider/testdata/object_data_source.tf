@@ -0,0 +1,21 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket = objsto_bucket.this.bucket
+  key    = "config.json"
+  content = jsonencode({
+    message = "Hello objsto!"
+  })
+}
+
+data "objsto_object" "this" {
+  bucket = objsto_object.this.bucket
+  key    = objsto_object.this.key
+}
@@ -0,0 +1,31 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "cache_control" {
+  type    = string
+  default = "max-age=3600"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "detected" {
+  bucket  = objsto_bucket.this.bucket
+  key     = "index.html"
+  content = "<h1>Hello objsto!</h1>"
+}
+
+resource "objsto_object" "this" {
+  bucket  = objsto_bucket.this.bucket
+  key     = "report"
+  content = "Hello objsto!"
+
+  content_type        = "text/plain"
+  cache_control       = var.cache_control
+  content_disposition = "attachment; filename=\"report.txt\""
+  content_language    = "en-US"
+  expires             = "2030-01-01T12:00:00+02:00"
+}
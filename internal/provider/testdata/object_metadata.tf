@@ -0,0 +1,24 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "build_id" {
+  type    = string
+  default = "1"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket  = objsto_bucket.this.bucket
+  key     = "build/app.js"
+  content = "console.log('Hello objsto!');"
+
+  metadata = {
+    Build-Id = var.build_id
+    git-sha  = "0123456789abcdef"
+  }
+}
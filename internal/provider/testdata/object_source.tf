@@ -0,0 +1,19 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "source_file" {
+  type = string
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket      = objsto_bucket.this.bucket
+  key         = "source.bin"
+  source      = var.source_file
+  source_hash = filemd5(var.source_file)
+}
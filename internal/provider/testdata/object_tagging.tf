@@ -0,0 +1,27 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "key" {
+  type    = string
+  default = "unmanaged.txt"
+}
+
+variable "tags" {
+  type    = map(string)
+  default = null
+}
+
+resource "objsto_bucket" "this" {
+  bucket        = var.bucket_name
+  force_destroy = true
+}
+
+resource "objsto_object_tagging" "this" {
+  count = var.tags == null ? 0 : 1
+
+  bucket = objsto_bucket.this.bucket
+  key    = var.key
+  tags   = var.tags
+}
@@ -0,0 +1,31 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "default_tags" {
+  type    = map(string)
+  default = {}
+}
+
+variable "tags" {
+  type    = map(string)
+  default = null
+}
+
+provider "objsto" {
+  default_tags {
+    tags = var.default_tags
+  }
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket  = objsto_bucket.this.bucket
+  key     = "tagged.txt"
+  content = "Hello objsto!"
+  tags    = var.tags
+}
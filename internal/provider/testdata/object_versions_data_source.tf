@@ -0,0 +1,50 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+variable "object_content" {
+  type    = string
+  default = "Hello objsto!"
+}
+
+resource "objsto_bucket" "this" {
+  bucket        = var.bucket_name
+  force_destroy = true
+}
+
+resource "objsto_bucket_versioning" "this" {
+  bucket = objsto_bucket.this.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "objsto_object" "this" {
+  count = var.object_content != "" ? 1 : 0
+
+  bucket  = objsto_bucket_versioning.this.bucket
+  key     = "object.txt"
+  content = var.object_content
+}
+
+resource "objsto_object" "other" {
+  bucket  = objsto_bucket_versioning.this.bucket
+  key     = "object.txt.bak"
+  content = "Backup"
+}
+
+data "objsto_object_versions" "this" {
+  bucket = objsto_bucket_versioning.this.bucket
+  key    = "object.txt"
+
+  depends_on = [objsto_object.this, objsto_object.other]
+}
+
+data "objsto_object_versions" "prefix" {
+  bucket = objsto_bucket_versioning.this.bucket
+  prefix = "object.txt"
+
+  depends_on = [objsto_object.this, objsto_object.other]
+}
@@ -0,0 +1,47 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  for_each = toset(["releases/v1/app.txt", "releases/v2/app.txt", "releases/latest.txt", "releases/v3.txt", "other.txt"])
+
+  bucket  = objsto_bucket.this.bucket
+  key     = each.value
+  content = each.value
+}
+
+data "objsto_objects" "all" {
+  bucket = objsto_bucket.this.bucket
+
+  depends_on = [objsto_object.this]
+}
+
+data "objsto_objects" "releases" {
+  bucket    = objsto_bucket.this.bucket
+  prefix    = "releases/"
+  delimiter = "/"
+
+  depends_on = [objsto_object.this]
+}
+
+data "objsto_objects" "limited" {
+  bucket      = objsto_bucket.this.bucket
+  start_after = "other.txt"
+  max_keys    = 2
+
+  depends_on = [objsto_object.this]
+}
+
+data "objsto_objects" "limited_releases" {
+  bucket    = objsto_bucket.this.bucket
+  prefix    = "releases/"
+  delimiter = "/"
+  max_keys  = 3
+
+  depends_on = [objsto_object.this]
+}
@@ -0,0 +1,67 @@
+variable "bucket_name" {
+  type    = string
+  default = "objsto-acc-test"
+}
+
+resource "objsto_bucket" "this" {
+  bucket = var.bucket_name
+}
+
+resource "objsto_object" "this" {
+  bucket  = objsto_bucket.this.bucket
+  key     = "hello.txt"
+  content = "Hello objsto!"
+}
+
+data "objsto_policy_document" "source" {
+  statement {
+    sid     = "GetObject"
+    effect  = "Deny"
+    actions = ["s3:GetObject"]
+    resources = [
+      "${objsto_bucket.this.arn}/*",
+    ]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+}
+
+data "objsto_policy_document" "this" {
+  policy_id               = "PublicRead"
+  source_policy_documents = [data.objsto_policy_document.source.json]
+
+  statement {
+    sid     = "ListBucket"
+    actions = ["s3:ListBucket", "s3:GetBucketLocation"]
+    resources = [
+      objsto_bucket.this.arn,
+    ]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+
+  override_policy_documents = [
+    jsonencode({
+      Statement = [
+        {
+          Sid       = "GetObject"
+          Effect    = "Allow"
+          Principal = "*"
+          Action    = "s3:GetObject"
+          Resource  = "${objsto_bucket.this.arn}/*"
+        },
+      ]
+    }),
+  ]
+}
+
+resource "objsto_bucket_policy" "this" {
+  bucket = objsto_bucket.this.bucket
+  policy = data.objsto_policy_document.this.json
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	defaultTimeout = 5 * time.Minute
+
+	// Creating or updating an object can upload a large file defined in source, which can take a long time.
+	defaultObjectUploadTimeout = 30 * time.Minute
+
+	// Deleting a bucket with force_destroy deletes all objects in the bucket, which can take a long time.
+	defaultBucketDeleteTimeout = 60 * time.Minute
+)
+
+// timeoutFunc returns the configured timeout or the default timeout, e.g., timeouts.Value.Create.
+type timeoutFunc func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// withTimeout returns a context that is cancelled when the configured timeout of the operation is exceeded. The returned function must be called when the operation is completed. It cancels the context and, if the operation failed because the timeout was exceeded, adds a diagnostic that explains how to increase the timeout.
+func withTimeout(ctx context.Context, timeout timeoutFunc, defaultTimeout time.Duration, operation string, diags *diag.Diagnostics) (context.Context, func()) {
+	duration, d := timeout(ctx, defaultTimeout)
+	diags.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, func() {
+		if diags.HasError() && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			diags.AddError(
+				"Timeout exceeded",
+				fmt.Sprintf("The %[1]s operation did not complete within the %[1]s timeout of %[2]s. The timeout can be increased by setting %[1]s in the timeouts block of the resource.", operation, duration),
+			)
+		}
+		cancel()
+	}
+}
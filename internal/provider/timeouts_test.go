@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	timeout := func(_ context.Context, _ time.Duration) (time.Duration, diag.Diagnostics) {
+		return time.Millisecond, nil
+	}
+
+	t.Run("Timeout exceeded", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ctx, done := withTimeout(t.Context(), timeout, defaultTimeout, "create", &diags)
+
+		<-ctx.Done()
+		diags.AddError("Unable to create bucket", ctx.Err().Error())
+		done()
+
+		assert.Len(t, diags.Errors(), 2)
+		assert.Equal(t, "Timeout exceeded", diags.Errors()[1].Summary())
+		assert.Contains(t, diags.Errors()[1].Detail(), "create timeout of 1ms")
+	})
+
+	t.Run("Completed", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ctx, done := withTimeout(t.Context(), timeout, defaultTimeout, "create", &diags)
+		done()
+
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+		assert.False(t, diags.HasError())
+	})
+}
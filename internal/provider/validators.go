@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
@@ -36,3 +37,64 @@ func (v isValidRFC3339) ValidateString(ctx context.Context, request validator.St
 		))
 	}
 }
+
+var _ validator.String = isValidDuration{}
+
+// isValidDuration accepts only positive durations, as the validated attributes configure timeouts and intervals.
+type isValidDuration struct{}
+
+// Description describes the validation.
+func (v isValidDuration) Description(_ context.Context) string {
+	return "must be a valid positive duration, e.g., 30s or 1h"
+}
+
+// MarkdownDescription describes the validation in Markdown.
+func (v isValidDuration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isValidDuration) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
+
+var _ validator.String = isValidBase64{}
+
+// isValidBase64 accepts only canonical standard base64 encoding, as the value is compared to the re-encoded content when the object is read.
+type isValidBase64 struct{}
+
+// Description describes the validation.
+func (v isValidBase64) Description(_ context.Context) string {
+	return "must be valid base64 with standard encoding and padding, and without line breaks, e.g., output of base64encode or filebase64 function"
+}
+
+// MarkdownDescription describes the validation in Markdown.
+func (v isValidBase64) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isValidBase64) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || base64.StdEncoding.EncodeToString(decoded) != value {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
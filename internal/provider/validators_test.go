@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidDuration(t *testing.T) {
+	tests := []struct {
+		value         types.String
+		expectedError bool
+	}{
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("30s")},
+		{value: types.StringValue("1h30m")},
+		{value: types.StringValue(""), expectedError: true},
+		{value: types.StringValue("0s"), expectedError: true},
+		{value: types.StringValue("-1m"), expectedError: true},
+		{value: types.StringValue("30"), expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value.String(), func(t *testing.T) {
+			var resp validator.StringResponse
+			isValidDuration{}.ValidateString(t.Context(), validator.StringRequest{
+				Path:        path.Root("dial_timeout"),
+				ConfigValue: test.value,
+			}, &resp)
+			assert.Equal(t, test.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestIsValidBase64(t *testing.T) {
+	tests := []struct {
+		value         types.String
+		expectedError bool
+	}{
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("")},
+		{value: types.StringValue("aGVsbG8=")},
+		{value: types.StringValue("aGVsbG8"), expectedError: true},
+		{value: types.StringValue("aGVsbG9=\n"), expectedError: true},
+		{value: types.StringValue("aGVsbG9="), expectedError: true},
+		{value: types.StringValue("aGVs\nbG8="), expectedError: true},
+		{value: types.StringValue("not base64!"), expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value.String(), func(t *testing.T) {
+			var resp validator.StringResponse
+			isValidBase64{}.ValidateString(t.Context(), validator.StringRequest{
+				Path:        path.Root("content_base64"),
+				ConfigValue: test.value,
+			}, &resp)
+			assert.Equal(t, test.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}